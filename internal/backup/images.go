@@ -0,0 +1,274 @@
+/**
+ * images.go
+ * Image mirroring for content exports
+ *
+ * Collects every image URL referenced by a Ghost JSON export (posts, pages,
+ * users, and settings) and mirrors the images into a local directory.
+ */
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GhostURLPlaceholder is the placeholder Ghost exports use for the site URL
+const GhostURLPlaceholder = "__GHOST_URL__"
+
+// imageFields are post, page, and user fields that hold a single image URL
+var imageFields = []string{
+	"feature_image",
+	"og_image",
+	"twitter_image",
+	"profile_image",
+	"cover_image",
+}
+
+// settingImageKeys are settings whose values are image URLs
+var settingImageKeys = map[string]bool{
+	"logo":                    true,
+	"icon":                    true,
+	"cover_image":             true,
+	"og_image":                true,
+	"twitter_image":           true,
+	"portal_button_icon":      true,
+	"newsletter_header_image": true,
+}
+
+// contentFields are post and page fields whose body may embed images
+var contentFields = []string{"html", "lexical", "mobiledoc", "codeinjection_head", "codeinjection_foot"}
+
+// contentImagePattern matches image URLs embedded in post bodies
+//
+// Lexical and mobiledoc bodies are JSON strings, so quotes may be escaped.
+var contentImagePattern = regexp.MustCompile(`(?:__GHOST_URL__|https?://[^\s"'<>()\\]+)/[^\s"'<>()\\]*\.(?i:jpe?g|png|gif|webp|svg|avif|ico)`)
+
+// export is the subset of a Ghost JSON export used for image collection
+type export struct {
+	DB []struct {
+		Data struct {
+			Posts    []map[string]interface{} `json:"posts"`
+			Users    []map[string]interface{} `json:"users"`
+			Settings []struct {
+				Key   string      `json:"key"`
+				Value interface{} `json:"value"`
+			} `json:"settings"`
+		} `json:"data"`
+	} `json:"db"`
+}
+
+// ImageURLs returns every image URL referenced by a Ghost JSON export
+//
+// The __GHOST_URL__ placeholder is replaced with siteURL. The result is sorted
+// and contains no duplicates.
+func ImageURLs(data []byte, siteURL string) ([]string, error) {
+	var exp export
+	if err := json.Unmarshal(data, &exp); err != nil {
+		return nil, fmt.Errorf("failed to parse export: %w", err)
+	}
+
+	seen := map[string]bool{}
+	add := func(raw string) {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			return
+		}
+		raw = strings.Replace(raw, GhostURLPlaceholder, strings.TrimRight(siteURL, "/"), 1)
+		if !strings.HasPrefix(raw, "http://") && !strings.HasPrefix(raw, "https://") {
+			return
+		}
+		seen[raw] = true
+	}
+
+	for _, db := range exp.DB {
+		// Posts (and pages, which share the posts table) and users
+		records := append(append([]map[string]interface{}{}, db.Data.Posts...), db.Data.Users...)
+		for _, record := range records {
+			for _, field := range imageFields {
+				if value, ok := record[field].(string); ok {
+					add(value)
+				}
+			}
+			for _, field := range contentFields {
+				if value, ok := record[field].(string); ok {
+					for _, match := range contentImagePattern.FindAllString(value, -1) {
+						add(match)
+					}
+				}
+			}
+		}
+
+		// Settings
+		for _, setting := range db.Data.Settings {
+			if value, ok := setting.Value.(string); ok && settingImageKeys[setting.Key] {
+				add(value)
+			}
+		}
+	}
+
+	urls := make([]string, 0, len(seen))
+	for u := range seen {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	return urls, nil
+}
+
+// LocalPath returns the path (relative to the mirror directory) for an image URL
+//
+// Images are stored under their host and URL path, e.g.
+// example.com/content/images/2024/01/photo.jpg. The path always stays
+// inside the mirror directory.
+func LocalPath(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid image URL %q: %w", rawURL, err)
+	}
+
+	cleaned := path.Clean("/" + u.Path)
+	if cleaned == "/" {
+		return "", fmt.Errorf("image URL has no path: %s", rawURL)
+	}
+	if u.RawQuery != "" {
+		// Keep images that differ only by query (e.g. resized CDN images) apart
+		cleaned += "_" + sanitize(u.RawQuery)
+	}
+
+	// A host of dots ("..") would be read as a parent directory
+	host := sanitize(u.Host)
+	if strings.Trim(host, ".") == "" {
+		host = strings.Repeat("_", len(host))
+	}
+
+	rel := filepath.Join(host, filepath.FromSlash(strings.TrimPrefix(cleaned, "/")))
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("image URL maps outside the mirror directory: %s", rawURL)
+	}
+	return rel, nil
+}
+
+// sanitize replaces characters that are unsafe in file names
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '?', '&', '=', '*', '"', '<', '>', '|', '\\', '/':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// MirrorResult is the outcome of mirroring one image
+type MirrorResult struct {
+	URL     string `json:"url"`
+	Path    string `json:"path,omitempty"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Mirror downloads images into dir with the given concurrency
+//
+// Images that already exist locally are skipped, so repeated backups only
+// download new images. Failures are reported per image rather than aborting.
+func Mirror(ctx context.Context, client *http.Client, urls []string, dir string, concurrency int) []MirrorResult {
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]MirrorResult, len(urls))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = mirrorOne(ctx, client, urls[i], dir)
+			}
+		}()
+	}
+	for i := range urls {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// mirrorOne downloads a single image
+func mirrorOne(ctx context.Context, client *http.Client, rawURL, dir string) MirrorResult {
+	result := MirrorResult{URL: rawURL}
+
+	rel, err := LocalPath(rawURL)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	dest := filepath.Join(dir, rel)
+	result.Path = dest
+
+	// Skip images that were already mirrored
+	if _, err := os.Stat(dest); err == nil {
+		result.Skipped = true
+		return result
+	}
+
+	if err := download(ctx, client, rawURL, dest); err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// download fetches a URL into dest, writing through a temporary file
+func download(ctx context.Context, client *http.Client, rawURL, dest string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download: HTTP %d", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dest), ".download-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/**
+ * images_test.go
+ * Test code for image mirroring
+ */
+
+package backup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// sampleExport is a trimmed Ghost JSON export
+const sampleExport = `{"db":[{"meta":{"version":"5.80.0"},"data":{
+  "posts":[
+    {"id":"1","type":"post","feature_image":"__GHOST_URL__/content/images/2024/01/cover.jpg",
+     "lexical":"{\"root\":{\"children\":[{\"type\":\"image\",\"src\":\"__GHOST_URL__/content/images/2024/01/inline.png\"}]}}",
+     "og_image":null},
+    {"id":"2","type":"page","feature_image":"https://images.unsplash.com/photo-123?w=2000",
+     "html":"<p><img src=\"https://cdn.example.org/a/b.webp\"></p><a href=\"https://example.org/doc.pdf\">doc</a>"}
+  ],
+  "users":[{"id":"u1","profile_image":"__GHOST_URL__/content/images/2023/05/me.jpg","cover_image":""}],
+  "settings":[
+    {"key":"logo","value":"__GHOST_URL__/content/images/logo.png"},
+    {"key":"title","value":"https://example.com/not-an-image.png"},
+    {"key":"icon","value":null}
+  ]
+}}]}`
+
+// TestImageURLs_CollectsAllSources tests collecting images from posts, pages, users, and settings
+func TestImageURLs_CollectsAllSources(t *testing.T) {
+	got, err := ImageURLs([]byte(sampleExport), "https://example.com/")
+	if err != nil {
+		t.Fatalf("ImageURLs() error: %v", err)
+	}
+
+	want := []string{
+		"https://cdn.example.org/a/b.webp",
+		"https://example.com/content/images/2023/05/me.jpg",
+		"https://example.com/content/images/2024/01/cover.jpg",
+		"https://example.com/content/images/2024/01/inline.png",
+		"https://example.com/content/images/logo.png",
+		"https://images.unsplash.com/photo-123?w=2000",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ImageURLs() = %v; want %v", got, want)
+	}
+}
+
+// TestLocalPath tests mapping image URLs to local paths
+func TestLocalPath(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://example.com/content/images/2024/01/a.jpg", filepath.Join("example.com", "content", "images", "2024", "01", "a.jpg")},
+		{"https://example.com/../../etc/passwd", filepath.Join("example.com", "etc", "passwd")},
+		{"https://images.unsplash.com/photo-1?w=2000", filepath.Join("images.unsplash.com", "photo-1_w_2000")},
+		{"http://../x.png", filepath.Join("__", "x.png")},
+		{"http://./x.png", filepath.Join("_", "x.png")},
+		{"https://example.com/a.png?x/../../../../etc", filepath.Join("example.com", "a.png_x_.._.._.._.._etc")},
+	}
+
+	for _, tt := range tests {
+		got, err := LocalPath(tt.url)
+		if err != nil {
+			t.Fatalf("LocalPath(%q) error: %v", tt.url, err)
+		}
+		if got != tt.want {
+			t.Errorf("LocalPath(%q) = %q; want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+// TestMirror_DownloadsAndSkipsExisting tests mirroring images and skipping existing ones
+func TestMirror_DownloadsAndSkipsExisting(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing.jpg" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("image:" + r.URL.Path))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	urls := []string{server.URL + "/content/images/a.jpg", server.URL + "/missing.jpg"}
+
+	results := Mirror(context.Background(), server.Client(), urls, dir, 2)
+	if results[0].Error != "" || results[0].Skipped {
+		t.Errorf("results[0] = %+v; want downloaded", results[0])
+	}
+	if results[1].Error == "" {
+		t.Errorf("results[1] = %+v; want error", results[1])
+	}
+	data, err := os.ReadFile(results[0].Path)
+	if err != nil || string(data) != "image:/content/images/a.jpg" {
+		t.Errorf("mirrored file = %q, %v", data, err)
+	}
+
+	// A second run skips the existing image
+	results = Mirror(context.Background(), server.Client(), urls[:1], dir, 1)
+	if !results[0].Skipped {
+		t.Errorf("results[0] = %+v; want skipped", results[0])
+	}
+}
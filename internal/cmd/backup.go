@@ -0,0 +1,196 @@
+/**
+ * backup.go
+ * Backup commands
+ *
+ * Provides full content export and import through Ghost's DB endpoint,
+ * optionally mirroring every referenced image into a local directory.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mtane0412/ghocli/internal/backup"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+)
+
+// BackupCmd is the backup command
+type BackupCmd struct {
+	Export BackupExportCmd `cmd:"" help:"Export all content to a JSON file"`
+	Import BackupImportCmd `cmd:"" help:"Import content from a JSON export"`
+}
+
+// BackupExportCmd is the command to export all content
+type BackupExportCmd struct {
+	Output        string `help:"Output file path (default: <site>.ghost.<timestamp>.json)" short:"o"`
+	IncludeImages bool   `help:"Also mirror every referenced image"`
+	ImagesDir     string `help:"Directory for mirrored images (existing images are skipped)" default:"images" type:"path"`
+	Concurrency   int    `help:"Number of parallel image downloads" default:"4"`
+}
+
+// Run executes the export subcommand of the backup command
+func (c *BackupExportCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get site information (for the file name and image URLs)
+	site, err := client.GetSite()
+	if err != nil {
+		return fmt.Errorf("failed to get site information: %w", err)
+	}
+
+	// Export content
+	data, err := client.ExportContent()
+	if err != nil {
+		return fmt.Errorf("failed to export content: %w", err)
+	}
+
+	// Write to file
+	output := c.Output
+	if output == "" {
+		output = backupFilename(site.URL, time.Now())
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	if !c.IncludeImages {
+		// Output as-is if JSON format
+		if root.JSON {
+			return formatter.Print(map[string]interface{}{"file": output})
+		}
+		formatter.PrintMessage(fmt.Sprintf("exported content: %s", output))
+		return nil
+	}
+
+	// Mirror images
+	urls, err := backup.ImageURLs(data, site.URL)
+	if err != nil {
+		return err
+	}
+	results := backup.Mirror(ctx, nil, urls, c.ImagesDir, c.Concurrency)
+
+	downloaded, skipped, failed := 0, 0, []backup.MirrorResult{}
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			failed = append(failed, result)
+		case result.Skipped:
+			skipped++
+		default:
+			downloaded++
+		}
+	}
+
+	// Output as-is if JSON format
+	if root.JSON {
+		if err := formatter.Print(map[string]interface{}{"file": output, "images": results}); err != nil {
+			return err
+		}
+	} else {
+		if len(failed) > 0 {
+			headers := []string{"URL", "Error"}
+			rows := make([][]string, len(failed))
+			for i, result := range failed {
+				rows[i] = []string{result.URL, result.Error}
+			}
+			if err := formatter.PrintTable(headers, rows); err != nil {
+				return err
+			}
+		}
+		formatter.PrintMessage(fmt.Sprintf("exported content: %s", output))
+		formatter.PrintMessage(fmt.Sprintf("images: %d downloaded, %d already present, %d failed (%s)", downloaded, skipped, len(failed), c.ImagesDir))
+	}
+
+	if len(failed) > 0 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("failed to mirror %d images", len(failed))}
+	}
+	return nil
+}
+
+// BackupImportCmd is the command to import content
+type BackupImportCmd struct {
+	File string `arg:"" help:"JSON export file" type:"existingfile"`
+}
+
+// Run executes the import subcommand of the backup command
+func (c *BackupImportCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get site information (named in the confirmation)
+	site, err := client.GetSite()
+	if err != nil {
+		return fmt.Errorf("failed to get site information: %w", err)
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("import %s into site '%s' (%s)", c.File, site.Title, site.URL)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Open file
+	file, err := os.Open(c.File)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	// Import content
+	problems, err := client.ImportContent(file, filepath.Base(c.File))
+	if err != nil {
+		return fmt.Errorf("failed to import content: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(map[string]interface{}{"file": c.File, "problems": problems})
+	}
+
+	// Show problems reported by Ghost
+	if len(problems) > 0 {
+		headers := []string{"Type", "Message", "Context"}
+		rows := make([][]string, len(problems))
+		for i, problem := range problems {
+			rows[i] = []string{problem.Help, problem.Message, problem.Context}
+		}
+		if err := formatter.PrintTable(headers, rows); err != nil {
+			return err
+		}
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("imported content: %s (%d problems)", c.File, len(problems)))
+
+	return nil
+}
+
+// backupFilename returns a timestamped file name for a content export
+//
+// The format follows Ghost's own exports: <host>.ghost.<YYYY-MM-DD-HH-MM-SS>.json
+func backupFilename(siteURL string, now time.Time) string {
+	name := "ghost"
+	if u, err := url.Parse(siteURL); err == nil && u.Hostname() != "" {
+		name = u.Hostname()
+	}
+	return fmt.Sprintf("%s.ghost.%s.json", name, now.Format("2006-01-02-15-04-05"))
+}
@@ -0,0 +1,48 @@
+/**
+ * backup_test.go
+ * Test code for backup commands
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+)
+
+// TestBackupCmd_StructExists verifies that backup command structs exist
+func TestBackupCmd_StructExists(t *testing.T) {
+	// Verify that backup commands are defined
+	_ = &BackupExportCmd{}
+	_ = &BackupImportCmd{}
+}
+
+// TestBackupExportCmd_ParseFlags verifies export flag defaults
+func TestBackupExportCmd_ParseFlags(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"backup", "export", "--include-images"}); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if !cli.Backup.Export.IncludeImages || cli.Backup.Export.Concurrency != 4 {
+		t.Errorf("Export = %+v; want include images with concurrency 4", cli.Backup.Export)
+	}
+}
+
+// TestBackupFilename verifies timestamped export file names
+func TestBackupFilename(t *testing.T) {
+	now := time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC)
+
+	if got := backupFilename("https://blog.example.com/", now); got != "blog.example.com.ghost.2024-03-05-14-07-09.json" {
+		t.Errorf("backupFilename() = %q", got)
+	}
+	if got := backupFilename("", now); got != "ghost.ghost.2024-03-05-14-07-09.json" {
+		t.Errorf("backupFilename(\"\") = %q", got)
+	}
+}
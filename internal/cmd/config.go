@@ -10,8 +10,11 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/mtane0412/ghocli/internal/config"
+	"github.com/mtane0412/ghocli/internal/markdown"
 )
 
 // ConfigCmd is the root command for configuration management
@@ -51,7 +54,19 @@ func (c *ConfigGetCmd) Run(ctx context.Context, root *RootFlags) error {
 	case "keyring_backend":
 		value = cfg.KeyringBackend
 	default:
-		return fmt.Errorf("unknown configuration key: %s", c.Key)
+		option, ok := markdownConfigKey(c.Key)
+		if !ok {
+			return fmt.Errorf("unknown configuration key: %s", c.Key)
+		}
+		opts, err := markdownOptions(cfg, nil)
+		if err != nil {
+			return err
+		}
+		enabled, err := opts.Get(option)
+		if err != nil {
+			return err
+		}
+		value = strconv.FormatBool(enabled)
 	}
 
 	// Output value
@@ -86,7 +101,21 @@ func (c *ConfigSetCmd) Run(ctx context.Context, root *RootFlags) error {
 	case "keyring_backend":
 		cfg.KeyringBackend = c.Value
 	default:
-		return fmt.Errorf("unknown configuration key: %s", c.Key)
+		option, ok := markdownConfigKey(c.Key)
+		if !ok {
+			return fmt.Errorf("unknown configuration key: %s", c.Key)
+		}
+		enabled, err := strconv.ParseBool(c.Value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %s (expected true or false)", c.Key, c.Value)
+		}
+		if err := (&markdown.Options{}).Set(option, enabled); err != nil {
+			return err
+		}
+		if cfg.Markdown == nil {
+			cfg.Markdown = make(map[string]bool)
+		}
+		cfg.Markdown[option] = enabled
 	}
 
 	// Save configuration
@@ -124,7 +153,15 @@ func (c *ConfigUnsetCmd) Run(ctx context.Context, root *RootFlags) error {
 	case "keyring_backend":
 		cfg.KeyringBackend = ""
 	default:
-		return fmt.Errorf("unknown configuration key: %s", c.Key)
+		// Unsetting a Markdown option restores its default
+		option, ok := markdownConfigKey(c.Key)
+		if !ok {
+			return fmt.Errorf("unknown configuration key: %s", c.Key)
+		}
+		if _, err := markdown.DefaultOptions().Get(option); err != nil {
+			return err
+		}
+		delete(cfg.Markdown, option)
 	}
 
 	// Save configuration
@@ -156,6 +193,14 @@ func (c *ConfigListCmd) Run(ctx context.Context, root *RootFlags) error {
 	// Display configuration
 	fmt.Printf("default_site=%s\n", cfg.DefaultSite)
 	fmt.Printf("keyring_backend=%s\n", cfg.KeyringBackend)
+	opts, err := markdownOptions(cfg, nil)
+	if err != nil {
+		return err
+	}
+	for _, option := range markdown.OptionNames() {
+		enabled, _ := opts.Get(option)
+		fmt.Printf("markdown.%s=%t\n", option, enabled)
+	}
 
 	return nil
 }
@@ -182,5 +227,28 @@ type ConfigKeysCmd struct{}
 func (c *ConfigKeysCmd) Run(ctx context.Context, root *RootFlags) error {
 	fmt.Println("default_site")
 	fmt.Println("keyring_backend")
+	for _, option := range markdown.OptionNames() {
+		fmt.Println("markdown." + option)
+	}
 	return nil
 }
+
+// markdownConfigKey returns the option name of a "markdown.<option>" key
+func markdownConfigKey(key string) (string, bool) {
+	option, ok := strings.CutPrefix(key, "markdown.")
+	return option, ok && option != ""
+}
+
+// markdownOptions returns the Markdown options from the configuration and
+// per-file front matter overrides (may be nil)
+func markdownOptions(cfg *config.Config, overrides map[string]bool) (markdown.Options, error) {
+	opts, err := markdown.DefaultOptions().Apply(cfg.Markdown)
+	if err != nil {
+		return opts, fmt.Errorf("invalid markdown configuration: %w", err)
+	}
+	opts, err = opts.Apply(overrides)
+	if err != nil {
+		return opts, fmt.Errorf("invalid markdown front matter: %w", err)
+	}
+	return opts, nil
+}
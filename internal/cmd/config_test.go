@@ -6,7 +6,10 @@
 package cmd
 
 import (
+	"context"
 	"testing"
+
+	"github.com/mtane0412/ghocli/internal/config"
 )
 
 // TestConfigGetCmd_StructExists verifies that ConfigGetCmd struct exists
@@ -44,3 +47,44 @@ func TestConfigKeysCmd_StructExists(t *testing.T) {
 	var cmd ConfigKeysCmd
 	_ = cmd
 }
+
+// TestConfigSetCmd_MarkdownOption verifies setting, getting, and unsetting Markdown options
+func TestConfigSetCmd_MarkdownOption(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := (&ConfigSetCmd{Key: "markdown.tables", Value: "false"}).Run(context.Background(), &RootFlags{}); err != nil {
+		t.Fatalf("set error = %v", err)
+	}
+	configPath, err := getConfigPath()
+	if err != nil {
+		t.Fatalf("getConfigPath() error = %v", err)
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	opts, err := markdownOptions(cfg, map[string]bool{"typographer": true})
+	if err != nil {
+		t.Fatalf("markdownOptions() error = %v", err)
+	}
+	if opts.Tables || !opts.Strikethrough || !opts.Typographer {
+		t.Errorf("markdownOptions() = %+v", opts)
+	}
+
+	if err := (&ConfigSetCmd{Key: "markdown.tables", Value: "maybe"}).Run(context.Background(), &RootFlags{}); err == nil {
+		t.Error("set with a non-boolean value should fail")
+	}
+	if err := (&ConfigSetCmd{Key: "markdown.emoji", Value: "true"}).Run(context.Background(), &RootFlags{}); err == nil {
+		t.Error("set with an unknown option should fail")
+	}
+	if err := (&ConfigUnsetCmd{Key: "markdown.tables"}).Run(context.Background(), &RootFlags{}); err != nil {
+		t.Fatalf("unset error = %v", err)
+	}
+	cfg, err = config.Load(configPath)
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	if _, ok := cfg.Markdown["tables"]; ok {
+		t.Errorf("markdown.tables was not unset: %v", cfg.Markdown)
+	}
+}
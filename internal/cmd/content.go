@@ -0,0 +1,135 @@
+/**
+ * content.go
+ * Post/page content loading
+ *
+ * Reads post and page content from --file or the inline content flags,
+ * uploads local images, and converts Markdown to Lexical (or to HTML for
+ * Ghost's source=html conversion with --markdown-via-html), and renders
+ * content back to Markdown for cat.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mtane0412/ghocli/internal/config"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/markdown"
+)
+
+// loadedContent is the content of a post or page ready to send
+type loadedContent struct {
+	HTML        string             // HTML for Ghost to convert (source=html)
+	Lexical     string             // Lexical JSON
+	FrontMatter *input.FrontMatter // Front matter of the file (nil if none)
+}
+
+// loadContent reads content from a file (format detected from its extension)
+// or from the inline --html, --markdown, and --lexical flags
+func loadContent(ctx context.Context, client *ghostapi.Client, file, htmlFlag, markdownFlag, lexicalFlag string, flags *ContentMetaFlags) (*loadedContent, error) {
+	loaded := &loadedContent{Lexical: lexicalFlag}
+
+	// Determine content and format
+	var source string
+	var format input.ContentFormat
+	switch {
+	case file != "":
+		var err error
+		source, format, loaded.FrontMatter, err = input.ReadContentWithFrontMatter(file, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+	case markdownFlag != "":
+		source, format = markdownFlag, input.FormatMarkdown
+	default:
+		source, format = htmlFlag, input.FormatHTML
+	}
+
+	// Upload local images and point the content at their URLs
+	if !flags.NoUploadImages {
+		var err error
+		source, err = newContentImages(ctx, client).rewrite(file, source, format, loaded.FrontMatter, flags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload local images: %w", err)
+		}
+	}
+
+	// Process according to format
+	switch format {
+	case input.FormatMarkdown:
+		opts, err := contentMarkdownOptions(loaded.FrontMatter)
+		if err != nil {
+			return nil, err
+		}
+		if flags.MarkdownViaHTML {
+			html, err := markdown.ConvertToHTMLWithOptions(source, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert markdown to HTML: %w", err)
+			}
+			loaded.HTML = html
+			break
+		}
+		lexicalJSON, err := markdown.ConvertToLexicalWithOptions(source, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert markdown to Lexical: %w", err)
+		}
+		loaded.Lexical = lexicalJSON
+	case input.FormatLexical:
+		// Use Lexical JSON as-is
+		loaded.Lexical = source
+	default:
+		// HTML (and unknown formats) are converted on the server
+		loaded.HTML = source
+	}
+
+	return loaded, nil
+}
+
+// contentMarkdownOptions returns the Markdown options from the configuration
+// and the front matter (may be nil) of the content
+func contentMarkdownOptions(fm *input.FrontMatter) (markdown.Options, error) {
+	configPath, err := getConfigPath()
+	if err != nil {
+		return markdown.Options{}, err
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return markdown.Options{}, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	var overrides map[string]bool
+	if fm != nil {
+		overrides = fm.Markdown
+	}
+	return markdownOptions(cfg, overrides)
+}
+
+// renderMarkdown renders post/page content as Markdown
+//
+// Lexical is preferred; HTML is used for content without Lexical (e.g.
+// legacy mobiledoc posts). With front matter, the output can be passed
+// back to create/update --file.
+func renderMarkdown(htmlContent, lexicalJSON string, fm *input.FrontMatter) (string, error) {
+	var body string
+	if lexicalJSON != "" {
+		var err error
+		body, err = markdown.FromLexicalString(lexicalJSON)
+		if err != nil {
+			return "", fmt.Errorf("failed to render Lexical as Markdown: %w", err)
+		}
+	} else {
+		body = markdown.FromHTML(htmlContent)
+	}
+
+	if fm == nil {
+		return body, nil
+	}
+	header, err := input.FormatFrontMatter(fm)
+	if err != nil {
+		return "", err
+	}
+	return header + "\n" + body, nil
+}
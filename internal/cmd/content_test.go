@@ -0,0 +1,166 @@
+/**
+ * content_test.go
+ * Test code for post/page content loading
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mtane0412/ghocli/internal/config"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/markdown"
+)
+
+// TestLoadContent_MarkdownFileToLexical verifies that Markdown files are converted locally
+func TestLoadContent_MarkdownFileToLexical(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	file := filepath.Join(t.TempDir(), "post.md")
+	if err := os.WriteFile(file, []byte("---\ntitle: Hello\n---\n```go\nx := 1\n```\n"), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	loaded, err := loadContent(context.Background(), nil, file, "", "", "", &ContentMetaFlags{})
+	if err != nil {
+		t.Fatalf("loadContent() error = %v", err)
+	}
+	if loaded.HTML != "" || !strings.Contains(loaded.Lexical, `"language":"go"`) {
+		t.Errorf("loadContent() = %+v; want Lexical with code language", loaded)
+	}
+	if loaded.FrontMatter == nil || loaded.FrontMatter.Title != "Hello" {
+		t.Errorf("FrontMatter = %+v", loaded.FrontMatter)
+	}
+}
+
+// TestLoadContent_MarkdownViaHTML verifies the HTML fallback
+func TestLoadContent_MarkdownViaHTML(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	loaded, err := loadContent(context.Background(), nil, "", "", "# Title", "", &ContentMetaFlags{MarkdownViaHTML: true})
+	if err != nil {
+		t.Fatalf("loadContent() error = %v", err)
+	}
+	if loaded.Lexical != "" || loaded.HTML != "<h1 id=\"title\">Title</h1>\n" {
+		t.Errorf("loadContent() = %+v; want HTML", loaded)
+	}
+}
+
+// TestLoadContent_MarkdownOptions verifies config options and front matter overrides
+func TestLoadContent_MarkdownOptions(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cfg := &config.Config{Markdown: map[string]bool{"tables": false, "heading_ids": false}}
+	if err := cfg.Save(filepath.Join(home, ".config", "gho", "config.json")); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	file := filepath.Join(t.TempDir(), "post.md")
+	content := "---\nmarkdown:\n  tables: true\n---\n# Title\n\n| A |\n|---|\n| 1 |\n\n~~old~~\n"
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	loaded, err := loadContent(context.Background(), nil, file, "", "", "", &ContentMetaFlags{MarkdownViaHTML: true})
+	if err != nil {
+		t.Fatalf("loadContent() error = %v", err)
+	}
+	for _, want := range []string{"<h1>Title</h1>", "<table>", "<del>old</del>"} {
+		if !strings.Contains(loaded.HTML, want) {
+			t.Errorf("HTML missing %q: %s", want, loaded.HTML)
+		}
+	}
+
+	// Unknown options in front matter are rejected
+	if err := os.WriteFile(file, []byte("---\nmarkdown:\n  emoji: true\n---\nText\n"), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	if _, err := loadContent(context.Background(), nil, file, "", "", "", &ContentMetaFlags{}); err == nil {
+		t.Error("loadContent() expected an error for an unknown markdown option")
+	}
+}
+
+// TestLoadContent_InlineHTMLAndLexical verifies that inline flags pass through
+func TestLoadContent_InlineHTMLAndLexical(t *testing.T) {
+	loaded, err := loadContent(context.Background(), nil, "", "<p>Hi</p>", "", `{"root":{}}`, &ContentMetaFlags{})
+	if err != nil {
+		t.Fatalf("loadContent() error = %v", err)
+	}
+	if loaded.HTML != "<p>Hi</p>" || loaded.Lexical != `{"root":{}}` {
+		t.Errorf("loadContent() = %+v", loaded)
+	}
+}
+
+// TestRenderMarkdown_FrontMatter verifies Markdown output with front matter
+func TestRenderMarkdown_FrontMatter(t *testing.T) {
+	featured := true
+	post := &ghostapi.Post{
+		Title:    "Hello",
+		Status:   "draft",
+		Featured: &featured,
+		Tags:     []ghostapi.Tag{{Name: "Go"}},
+		Authors:  []ghostapi.Author{{Slug: "jane"}, {Email: "joe@example.com", Slug: "joe"}},
+		Lexical:  `{"root":{"type":"root","children":[{"type":"heading","tag":"h2","children":[{"type":"extended-text","text":"Intro","format":0}]}]}}`,
+	}
+
+	got, err := renderMarkdown(post.HTML, post.Lexical, postFields(post).frontMatter())
+	if err != nil {
+		t.Fatalf("renderMarkdown() error = %v", err)
+	}
+	want := "---\ntitle: Hello\nstatus: draft\ntags:\n    - Go\nauthors:\n    - jane\n    - joe@example.com\nfeatured: true\n---\n\n## Intro\n"
+	if got != want {
+		t.Errorf("renderMarkdown() = %q; want %q", got, want)
+	}
+}
+
+// TestRenderMarkdown_HTMLFallback verifies that HTML is used without Lexical
+func TestRenderMarkdown_HTMLFallback(t *testing.T) {
+	got, err := renderMarkdown("<p>Hi <em>there</em></p>", "", nil)
+	if err != nil {
+		t.Fatalf("renderMarkdown() error = %v", err)
+	}
+	if got != "Hi *there*\n" {
+		t.Errorf("renderMarkdown() = %q", got)
+	}
+}
+
+// realisticMarkdown is post content with characters that need escaping in Markdown
+const realisticMarkdown = "## Using snake_case & friends\n\n" +
+	"Set `max_retries` to 3 (a < b, not a [link]). Prices: 5 * 2 = 10, back\\\\slash, Tom &amp; Jerry.\n\n" +
+	"- item_one\n- **bold_two** and *emph*\n\n" +
+	"```go\nx := a_b * c\n```\n\n" +
+	"See [the docs](https://example.com/a_b \"Docs\").\n"
+
+// TestRenderMarkdown_RoundTripThroughFile verifies that cat --format markdown
+// --front-matter output fed back to --file gives the same Lexical
+func TestRenderMarkdown_RoundTripThroughFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	lexicalJSON, err := markdown.ConvertToLexical(realisticMarkdown)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	post := &ghostapi.Post{Title: "Snake_case & co", Status: "draft", Tags: []ghostapi.Tag{{Name: "Go"}}, Lexical: lexicalJSON}
+
+	text, err := renderMarkdown(post.HTML, post.Lexical, postFields(post).frontMatter())
+	if err != nil {
+		t.Fatalf("renderMarkdown() error: %v", err)
+	}
+	file := filepath.Join(t.TempDir(), "post.md")
+	if err := os.WriteFile(file, []byte(text), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	loaded, err := loadContent(context.Background(), nil, file, "", "", "", &ContentMetaFlags{})
+	if err != nil {
+		t.Fatalf("loadContent() error = %v", err)
+	}
+	if loaded.Lexical != lexicalJSON {
+		t.Errorf("Lexical changed in round trip via:\n%s\ngot  %s\nwant %s", text, loaded.Lexical, lexicalJSON)
+	}
+	if loaded.FrontMatter == nil || loaded.FrontMatter.Title != post.Title {
+		t.Errorf("FrontMatter = %+v", loaded.FrontMatter)
+	}
+}
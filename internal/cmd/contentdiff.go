@@ -0,0 +1,222 @@
+/**
+ * contentdiff.go
+ * Differences between a post/page and a local content file
+ *
+ * Both sides are normalized (to Markdown or plain text) before diffing, so
+ * only real content changes show up. Metadata is compared as it would be
+ * after "update --file": front matter fields that are set replace the
+ * current values, the others stay as they are.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/k3a/html2text"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/lexical"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+	"github.com/mtane0412/ghocli/internal/textdiff"
+	"github.com/mtane0412/ghocli/internal/ui"
+	"github.com/muesli/termenv"
+)
+
+// ContentDiffFlags are flags shared by posts/pages diff
+type ContentDiffFlags struct {
+	Format   string `help:"Normalize content to (markdown, text)" default:"markdown" enum:"markdown,text"`
+	Context  int    `help:"Number of context lines" default:"3"`
+	ExitCode bool   `help:"Exit with status 1 if there are differences"`
+}
+
+// metadataChange is a changed metadata field
+type metadataChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// contentDiff is the difference between a post/page and a file
+type contentDiff struct {
+	From     string           `json:"from"`
+	To       string           `json:"to"`
+	Metadata []metadataChange `json:"metadata"`
+	Content  string           `json:"content"` // Unified diff hunks ("" if unchanged)
+}
+
+// Changed reports whether there are any differences
+func (d *contentDiff) Changed() bool {
+	return len(d.Metadata) > 0 || d.Content != ""
+}
+
+// String formats the diff as a unified diff (metadata first)
+func (d *contentDiff) String() string {
+	if !d.Changed() {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", d.From, d.To)
+	if len(d.Metadata) > 0 {
+		b.WriteString("@@ metadata @@\n")
+		for _, change := range d.Metadata {
+			if change.Old != "" {
+				fmt.Fprintf(&b, "-%s: %s\n", change.Field, change.Old)
+			}
+			if change.New != "" {
+				fmt.Fprintf(&b, "+%s: %s\n", change.Field, change.New)
+			}
+		}
+	}
+	b.WriteString(d.Content)
+	return b.String()
+}
+
+// diffContent compares a post/page with a loaded file
+//
+// current holds the metadata of the post/page; updated holds it with the
+// file's front matter applied.
+func diffContent(from, to string, current, updated contentFields, htmlContent, lexicalJSON string, file *loadedContent, flags ContentDiffFlags) (*contentDiff, error) {
+	diff := &contentDiff{From: from, To: to, Metadata: diffMetadata(current, updated)}
+
+	// A file without content leaves the content as it is
+	if file.HTML == "" && file.Lexical == "" {
+		return diff, nil
+	}
+
+	oldText, err := normalizeContent(htmlContent, lexicalJSON, flags.Format)
+	if err != nil {
+		return nil, err
+	}
+	newText, err := normalizeContent(file.HTML, file.Lexical, flags.Format)
+	if err != nil {
+		return nil, err
+	}
+	diff.Content = textdiff.Hunks(oldText, newText, flags.Context)
+	return diff, nil
+}
+
+// normalizeContent renders content as Markdown or plain text
+func normalizeContent(htmlContent, lexicalJSON, format string) (string, error) {
+	if format == "markdown" {
+		return renderMarkdown(htmlContent, lexicalJSON, nil)
+	}
+
+	// Render Lexical locally so both sides go through the same HTML
+	if lexicalJSON != "" {
+		var err error
+		htmlContent, err = lexical.RenderHTMLString(lexicalJSON)
+		if err != nil {
+			return "", fmt.Errorf("failed to render Lexical: %w", err)
+		}
+	}
+	return html2text.HTML2Text(htmlContent) + "\n", nil
+}
+
+// diffMetadata compares the front matter form of two sets of metadata fields
+func diffMetadata(current, updated contentFields) []metadataChange {
+	oldFM, newFM := current.frontMatter(), updated.frontMatter()
+	normalizeDiffFrontMatter(oldFM, *current.Authors)
+	normalizeDiffFrontMatter(newFM, *current.Authors)
+
+	var changes []metadataChange
+	oldValue, newValue := reflect.ValueOf(oldFM).Elem(), reflect.ValueOf(newFM).Elem()
+	for i := 0; i < oldValue.NumField(); i++ {
+		name := strings.Split(oldValue.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if name == "id" || name == "updated_at" || name == "markdown" {
+			continue
+		}
+		oldText, newText := metadataValue(oldValue.Field(i)), metadataValue(newValue.Field(i))
+		if oldText != newText {
+			changes = append(changes, metadataChange{Field: name, Old: oldText, New: newText})
+		}
+	}
+	return changes
+}
+
+// normalizeDiffFrontMatter makes equal values compare equal: publish dates
+// in UTC, and authors given by slug shown like the current authors
+func normalizeDiffFrontMatter(fm *input.FrontMatter, authors []ghostapi.Author) {
+	if t, err := time.Parse(time.RFC3339, fm.PublishedAt); err == nil {
+		fm.PublishedAt = t.UTC().Format(time.RFC3339)
+	}
+	for i, name := range fm.Authors {
+		for _, author := range authors {
+			if author.Slug == name && author.Email != "" {
+				fm.Authors[i] = author.Email
+			}
+		}
+	}
+}
+
+// metadataValue formats a front matter field for the diff
+func metadataValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return ""
+		}
+		return fmt.Sprint(v.Elem().Interface())
+	case reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(items, ", ")
+	default:
+		// Keep multi-line values (e.g. code injection) on one diff line
+		return strings.ReplaceAll(fmt.Sprint(v.Interface()), "\n", `\n`)
+	}
+}
+
+// printContentDiff prints a diff (colored on terminals) and applies --exit-code
+func printContentDiff(w io.Writer, root *RootFlags, diff *contentDiff, exitCode bool) error {
+	// Output as-is if JSON format
+	if root.JSON {
+		if err := outfmt.NewFormatter(w, root.GetOutputMode()).Print(diff); err != nil {
+			return err
+		}
+	} else {
+		profile := termenv.Ascii
+		if ui.ShouldUseColor(ui.ColorMode(root.Color)) {
+			profile = termenv.ANSI
+		}
+		fmt.Fprint(w, colorizeDiff(diff.String(), profile))
+	}
+
+	if exitCode && diff.Changed() {
+		return &ExitError{Code: 1, Err: fmt.Errorf("%s differs from %s", diff.To, diff.From)}
+	}
+	return nil
+}
+
+// colorizeDiff colors the lines of a unified diff
+func colorizeDiff(out string, profile termenv.Profile) string {
+	// Don't colorize if color profile is Ascii
+	if profile == termenv.Ascii {
+		return out
+	}
+
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		style := termenv.String(line)
+		switch {
+		case i < 2 && (strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++")):
+			style = style.Bold()
+		case strings.HasPrefix(line, "@@"):
+			style = style.Foreground(profile.Color("6"))
+		case strings.HasPrefix(line, "-"):
+			style = style.Foreground(profile.Color("1"))
+		case strings.HasPrefix(line, "+"):
+			style = style.Foreground(profile.Color("2"))
+		default:
+			continue
+		}
+		lines[i] = style.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,120 @@
+/**
+ * contentdiff_test.go
+ * Test code for post/page diffs
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/markdown"
+	"github.com/muesli/termenv"
+)
+
+// TestContentDiffCmd_StructExists verifies that diff command structs exist
+func TestContentDiffCmd_StructExists(t *testing.T) {
+	// Verify that diff commands are defined
+	_ = &PostsDiffCmd{}
+	_ = &PagesDiffCmd{}
+}
+
+// TestDiffContent verifies metadata and normalized content differences
+func TestDiffContent(t *testing.T) {
+	published := time.Date(2024, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	post := &ghostapi.Post{
+		ID:          "1",
+		Title:       "Old title",
+		Slug:        "hello",
+		Status:      "draft",
+		PublishedAt: &published,
+		Tags:        []ghostapi.Tag{{Name: "go"}},
+		Authors:     []ghostapi.Author{{Email: "alice@example.com", Slug: "alice"}},
+		HTML:        "<h2>Intro</h2><p>Hello <em>world</em></p>",
+	}
+
+	// The file sets the same date (in UTC) and author (by slug): no metadata change
+	fm := &input.FrontMatter{Title: "New title", Tags: []string{"go", "cli"}, PublishedAt: "2024-01-01T00:00:00Z", Authors: []string{"alice"}}
+	meta, err := resolveContentMeta(fm, "", "", ContentMetaFlags{})
+	if err != nil {
+		t.Fatalf("resolveContentMeta() error: %v", err)
+	}
+	updated := *post
+	meta.apply(postFields(&updated))
+
+	lexicalJSON, err := markdown.ConvertToLexical("## Intro\n\nHello _world_!\n")
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	file := &loadedContent{Lexical: lexicalJSON, FrontMatter: fm}
+
+	diff, err := diffContent("post 1", "post.md", postFields(post), postFields(&updated), post.HTML, post.Lexical, file, ContentDiffFlags{Format: "markdown", Context: 3})
+	if err != nil {
+		t.Fatalf("diffContent() error: %v", err)
+	}
+
+	want := "--- post 1\n+++ post.md\n" +
+		"@@ metadata @@\n-title: Old title\n+title: New title\n-tags: go\n+tags: go, cli\n" +
+		"@@ -1,3 +1,3 @@\n ## Intro\n \n-Hello *world*\n+Hello *world*!\n"
+	if got := diff.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestDiffContent_Unchanged verifies that equal content and metadata produce no diff
+func TestDiffContent_Unchanged(t *testing.T) {
+	post := &ghostapi.Post{ID: "1", Title: "Same", HTML: "<p>Body</p>"}
+	file := &loadedContent{HTML: "<p>Body</p>"}
+
+	diff, err := diffContent("post 1", "post.html", postFields(post), postFields(post), post.HTML, post.Lexical, file, ContentDiffFlags{Format: "text", Context: 3})
+	if err != nil {
+		t.Fatalf("diffContent() error: %v", err)
+	}
+	if diff.Changed() || diff.String() != "" {
+		t.Errorf("diff = %+v; want no changes", diff)
+	}
+}
+
+// TestPrintContentDiff_ExitCode verifies --exit-code
+func TestPrintContentDiff_ExitCode(t *testing.T) {
+	root := &RootFlags{Color: "never"}
+	diff := &contentDiff{From: "post 1", To: "post.md", Metadata: []metadataChange{{Field: "title", Old: "a", New: "b"}}}
+
+	var out bytes.Buffer
+	if err := printContentDiff(&out, root, diff, false); err != nil {
+		t.Errorf("printContentDiff() without --exit-code error: %v", err)
+	}
+	if !strings.Contains(out.String(), "+title: b") {
+		t.Errorf("output = %q", out.String())
+	}
+
+	err := printContentDiff(&out, root, diff, true)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Errorf("printContentDiff() with --exit-code = %v; want ExitError with code 1", err)
+	}
+	if err := printContentDiff(&out, root, &contentDiff{}, true); err != nil {
+		t.Errorf("printContentDiff() without changes error: %v", err)
+	}
+}
+
+// TestColorizeDiff verifies that only diff lines are colored
+func TestColorizeDiff(t *testing.T) {
+	diff := "--- a\n+++ b\n@@ -1 +1 @@\n-old\n+new\n ctx\n"
+	if got := colorizeDiff(diff, termenv.Ascii); got != diff {
+		t.Errorf("colorizeDiff() with Ascii = %q", got)
+	}
+
+	got := colorizeDiff(diff, termenv.ANSI)
+	for _, want := range []string{"\x1b[31m-old\x1b[0m", "\x1b[32m+new\x1b[0m", "\n ctx\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("colorizeDiff() = %q; missing %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+/**
+ * contentedit.go
+ * Editing posts and pages in a local editor
+ *
+ * The post or page is written to a temporary file as Markdown with front
+ * matter and opened in $VISUAL/$EDITOR. Saved changes are pushed back. If
+ * the post was changed on the site in the meantime (updated_at moved), the
+ * file can be re-opened with conflict markers around the differences.
+ * The file is the full state: front matter fields that are removed are
+ * cleared, and the body is only sent when it was changed.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+	"github.com/mtane0412/ghocli/internal/textdiff"
+)
+
+// ContentEditFlags are flags shared by posts/pages edit
+type ContentEditFlags struct {
+	Editor string `help:"Editor command (default: $VISUAL, then $EDITOR, then vi)"`
+}
+
+// editorCommand returns the editor command and its arguments
+func editorCommand(flag string) []string {
+	for _, editor := range []string{flag, os.Getenv("VISUAL"), os.Getenv("EDITOR")} {
+		if fields := strings.Fields(editor); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// editableMarkdown renders a post or page as Markdown with front matter
+func editableMarkdown(doc *syncDocument) (string, error) {
+	return renderMarkdown(doc.HTML, doc.Lexical, doc.fields.frontMatter())
+}
+
+// sameBody reports whether the body of a Markdown file is the rendering of
+// the current body of a post or page (front matter is not compared)
+func sameBody(doc *syncDocument, text string) (bool, error) {
+	rendered, err := editableMarkdown(doc)
+	if err != nil {
+		return false, err
+	}
+	_, renderedBody := input.SplitFrontMatter(rendered)
+	_, body := input.SplitFrontMatter(text)
+	return strings.TrimSpace(body) == strings.TrimSpace(renderedBody), nil
+}
+
+// editContent opens a post or page in an editor and saves the changes
+func editContent(ctx context.Context, root *RootFlags, kindName, id string, flags ContentEditFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+	return editContentWith(ctx, root, client, kindName, id, flags)
+}
+
+// editContentWith edits a post or page using the given API client
+func editContentWith(ctx context.Context, root *RootFlags, client *ghostapi.Client, kindName, id string, flags ContentEditFlags) error {
+	kind := syncKinds[kindName]
+	doc, err := kind.fetch(client, id)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", kindName, err)
+	}
+	text, err := editableMarkdown(doc)
+	if err != nil {
+		return err
+	}
+
+	// Write the temporary file (removed unless it holds unsaved edits)
+	file, err := os.CreateTemp("", "gho-"+kindName+"-*.md")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := file.Name()
+	file.Close()
+	keep := false
+	defer func() {
+		if !keep {
+			os.Remove(path)
+		}
+	}()
+
+	editor := editorCommand(flags.Editor)
+	for {
+		if err := os.WriteFile(path, []byte(text), 0600); err != nil {
+			return fmt.Errorf("failed to write temporary file: %w", err)
+		}
+		if err := runCommand(editor[0], append(editor[1:], path)...); err != nil {
+			return fmt.Errorf("failed to run editor %s: %w", editor[0], err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read temporary file: %w", err)
+		}
+		edited := string(data)
+
+		if textdiff.HasConflictMarkers(edited) {
+			if !confirmPrompt(ctx, root, "The file still has conflict markers. Re-open the editor?") {
+				keep = true
+				return &ExitError{Code: 1, Err: fmt.Errorf("not saved: conflict markers left in %s", path)}
+			}
+			text = edited
+			continue
+		}
+		if edited == text {
+			outfmt.NewFormatter(os.Stdout, root.GetOutputMode()).PrintMessage("no changes")
+			return nil
+		}
+
+		// Check for changes made on the site while editing
+		latest, err := kind.fetch(client, doc.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", kindName, err)
+		}
+		if !latest.UpdatedAt.Equal(doc.UpdatedAt) && !root.Force {
+			question := fmt.Sprintf("The %s was changed on the site while you were editing. Re-open with conflict markers?", kindName)
+			if !confirmPrompt(ctx, root, question) {
+				keep = true
+				return &ExitError{Code: 1, Err: fmt.Errorf("%s %s was changed on the site (use --force to overwrite); your edits are kept in %s", kindName, doc.ID, path)}
+			}
+			theirs, err := editableMarkdown(latest)
+			if err != nil {
+				return err
+			}
+			doc = latest
+			text = textdiff.Conflicts(theirs, edited, "site (updated "+syncTime(latest.UpdatedAt)+")", "your edit")
+			continue
+		}
+
+		// Save on top of the latest version (its updated_at is used for optimistic locking).
+		// Local images are not uploaded: relative paths would resolve against the temporary directory.
+		content, err := loadContent(ctx, client, path, "", "", "", &ContentMetaFlags{NoUploadImages: true})
+		if err != nil {
+			keep = true
+			return fmt.Errorf("%w (your edits are kept in %s)", err, path)
+		}
+		meta, err := resolveContentMeta(content.FrontMatter, "", "", ContentMetaFlags{})
+		if err != nil {
+			keep = true
+			return fmt.Errorf("%w (your edits are kept in %s)", err, path)
+		}
+
+		// An unchanged body is not sent: converting Markdown back is not exact for every card
+		unchanged, err := sameBody(latest, edited)
+		if err != nil {
+			keep = true
+			return fmt.Errorf("%w (your edits are kept in %s)", err, path)
+		}
+		if unchanged {
+			content.HTML, content.Lexical = "", ""
+		}
+		saved, err := kind.save(client, latest, meta, content)
+		if err != nil {
+			keep = true
+			return fmt.Errorf("failed to update %s: %w (your edits are kept in %s)", kindName, err, path)
+		}
+
+		// Create output formatter
+		formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+		// Output as-is if JSON format
+		if root.JSON {
+			return formatter.Print(map[string]string{"id": saved.ID, "title": saved.Title, "updated_at": syncTime(saved.UpdatedAt)})
+		}
+		formatter.PrintMessage(fmt.Sprintf("updated %s: %s (ID: %s)", kindName, saved.Title, saved.ID))
+		return nil
+	}
+}
+
+// confirmPrompt asks a yes/no question (defaults to yes; false when input is not interactive)
+func confirmPrompt(ctx context.Context, root *RootFlags, question string) bool {
+	if root.NoInput || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false
+	}
+	line, err := input.PromptLineFrom(ctx, question+" [Y/n]: ", os.Stdin)
+	if err != nil {
+		return false
+	}
+	ans := strings.ToLower(strings.TrimSpace(line))
+	return ans == "" || ans == "y" || ans == "yes"
+}
@@ -0,0 +1,177 @@
+/**
+ * contentedit_test.go
+ * Test code for editing posts/pages in a local editor
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/markdown"
+)
+
+// TestContentEditCmd_StructExists verifies that edit command structs exist
+func TestContentEditCmd_StructExists(t *testing.T) {
+	// Verify that edit commands are defined
+	_ = &PostsEditCmd{}
+	_ = &PagesEditCmd{}
+}
+
+// TestEditorCommand verifies the editor lookup order
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+	if got := editorCommand(""); !reflect.DeepEqual(got, []string{"vi"}) {
+		t.Errorf("editorCommand() without settings = %v", got)
+	}
+
+	t.Setenv("EDITOR", "nano")
+	t.Setenv("VISUAL", "code --wait")
+	if got := editorCommand(""); !reflect.DeepEqual(got, []string{"code", "--wait"}) {
+		t.Errorf("editorCommand() = %v; want $VISUAL", got)
+	}
+	if got := editorCommand("vim"); !reflect.DeepEqual(got, []string{"vim"}) {
+		t.Errorf("editorCommand(flag) = %v; want the flag", got)
+	}
+}
+
+// TestEditableMarkdown verifies that the edited text can be read back as front matter and content
+func TestEditableMarkdown(t *testing.T) {
+	post := &ghostapi.Post{ID: "1", Title: "Hello", Slug: "hello", Status: "draft", Tags: []ghostapi.Tag{{Name: "go"}}, HTML: "<p>Body</p>"}
+
+	text, err := editableMarkdown(postDocument(post))
+	if err != nil {
+		t.Fatalf("editableMarkdown() error: %v", err)
+	}
+	fm, body, err := input.ParseFrontMatter(text)
+	if err != nil {
+		t.Fatalf("ParseFrontMatter() error: %v", err)
+	}
+	if fm.Title != "Hello" || fm.Slug != "hello" || !reflect.DeepEqual([]string(fm.Tags), []string{"go"}) {
+		t.Errorf("front matter = %+v", fm)
+	}
+	if strings.TrimSpace(body) != "Body" {
+		t.Errorf("body = %q", body)
+	}
+}
+
+// sedEditor writes an "editor" that runs a sed script on the file
+func sedEditor(t *testing.T, script string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "editor.sh")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\nsed -i '"+script+"' \"$1\"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// TestEditContent_FrontMatterOnlyKeepsBody verifies that changing only the
+// front matter leaves the body out of the update
+func TestEditContent_FrontMatterOnlyKeepsBody(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	lexicalJSON, err := markdown.ConvertToLexical(realisticMarkdown + "\n![Chart](images/chart.png)\n")
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	const id = "65a1b2c3d4e5f60718293a4b"
+	ghost, client := newFakeGhost(t, &ghostapi.Post{
+		ID: id, Title: "Old title", Slug: "snake-case", Status: "draft",
+		Lexical: lexicalJSON, UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	// The "editor" changes only the title
+	editor := sedEditor(t, "s/^title: .*/title: New title/")
+	root := &RootFlags{NoInput: true}
+	if err := editContentWith(context.Background(), root, client, "post", id, ContentEditFlags{Editor: editor}); err != nil {
+		t.Fatalf("editContentWith() error: %v", err)
+	}
+	if len(ghost.updates) != 1 {
+		t.Fatalf("got %d updates; want 1", len(ghost.updates))
+	}
+	if got := ghost.updates[0]; got.Title != "New title" || got.Lexical != "" || got.HTML != "" {
+		t.Errorf("saved title %q, HTML %q, Lexical %q; want the new title only", got.Title, got.HTML, got.Lexical)
+	}
+	if got := ghost.posts[id].Lexical; got != lexicalJSON {
+		t.Errorf("Lexical on the site:\n%s\nwant:\n%s", got, lexicalJSON)
+	}
+}
+
+// TestEditContent_CardsWithoutMarkdownForm verifies that a post with cards
+// and formats that Markdown cannot express is not changed by a metadata edit
+func TestEditContent_CardsWithoutMarkdownForm(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	const lexicalJSON = `{"root":{"children":[` +
+		`{"children":[{"detail":0,"format":8,"mode":"normal","style":"","text":"underlined","type":"extended-text","version":1}],"direction":"ltr","format":"","indent":0,"type":"paragraph","version":1},` +
+		`{"html":"<p>Hi {first_name}, thanks for subscribing.</p>","type":"email","version":1}` +
+		`],"direction":"ltr","format":"","indent":0,"type":"root","version":1}}`
+	const id = "65a1b2c3d4e5f60718293a4b"
+	ghost, client := newFakeGhost(t, &ghostapi.Post{
+		ID: id, Title: "Welcome", Slug: "welcome", Status: "draft",
+		Lexical: lexicalJSON, UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	editor := sedEditor(t, "s/^slug: .*/slug: hello/")
+	root := &RootFlags{NoInput: true}
+	if err := editContentWith(context.Background(), root, client, "post", id, ContentEditFlags{Editor: editor}); err != nil {
+		t.Fatalf("editContentWith() error: %v", err)
+	}
+	if len(ghost.fields) != 1 {
+		t.Fatalf("got %d updates; want 1", len(ghost.fields))
+	}
+	for _, name := range []string{"lexical", "html"} {
+		if value, ok := ghost.fields[0][name]; ok {
+			t.Errorf("update sent %s = %s; want it left out", name, value)
+		}
+	}
+	if got := ghost.posts[id]; got.Slug != "hello" || got.Lexical != lexicalJSON {
+		t.Errorf("saved slug %q, Lexical:\n%s\nwant:\n%s", got.Slug, got.Lexical, lexicalJSON)
+	}
+}
+
+// TestEditContent_RemovedFieldsAreCleared verifies that front matter fields
+// deleted in the editor are cleared on the site
+func TestEditContent_RemovedFieldsAreCleared(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	const id = "65a1b2c3d4e5f60718293a4b"
+	featured := true
+	ghost, client := newFakeGhost(t, &ghostapi.Post{
+		ID: id, Title: "Hello", Slug: "hello", Status: "draft", HTML: "<p>Body</p>",
+		CustomExcerpt: "Short", FeatureImage: "https://example.com/a.png", MetaTitle: "SEO title",
+		Featured: &featured, Tags: []ghostapi.Tag{{Name: "go"}, {Name: "cli"}},
+		UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Delete the fields (tags are a list, so their items go too)
+	editor := sedEditor(t, "/^custom_excerpt:/d;/^feature_image:/d;/^meta_title:/d;/^featured:/d;/^tags:/d;/^  *- /d")
+	root := &RootFlags{NoInput: true}
+	if err := editContentWith(context.Background(), root, client, "post", id, ContentEditFlags{Editor: editor}); err != nil {
+		t.Fatalf("editContentWith() error: %v", err)
+	}
+	if len(ghost.fields) != 1 {
+		t.Fatalf("got %d updates; want 1", len(ghost.fields))
+	}
+	fields := ghost.fields[0]
+	for _, name := range []string{"custom_excerpt", "feature_image", "meta_title"} {
+		if got := string(fields[name]); got != "null" {
+			t.Errorf("%s = %q; want null", name, got)
+		}
+	}
+	if got := string(fields["tags"]); got != "[]" {
+		t.Errorf("tags = %q; want []", got)
+	}
+	if got := string(fields["featured"]); got != "false" {
+		t.Errorf("featured = %q; want false", got)
+	}
+	if got := string(fields["title"]); got != `"Hello"` {
+		t.Errorf("title = %s; want it kept", got)
+	}
+}
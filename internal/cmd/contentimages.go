@@ -0,0 +1,145 @@
+/**
+ * contentimages.go
+ * Automatic upload of local images in post/page content
+ *
+ * Uploads images that content references by local path and rewrites
+ * the references to the uploaded URLs. Uploads are cached per site so
+ * re-running a command reuses earlier URLs.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mtane0412/ghocli/internal/filetype"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/localimages"
+	"github.com/mtane0412/ghocli/internal/ui"
+)
+
+// contentImages uploads local images for one command run
+type contentImages struct {
+	client    *ghostapi.Client
+	output    *ui.Output
+	cachePath string
+	cache     *localimages.Cache // Loaded on first upload
+	dirty     bool
+}
+
+// newContentImages creates an uploader using the default cache file
+func newContentImages(ctx context.Context, client *ghostapi.Client) *contentImages {
+	output := ui.FromContext(ctx)
+	if output == nil {
+		output = ui.NewOutput(os.Stdout, os.Stderr)
+	}
+	cachePath, _ := localimages.DefaultCachePath()
+	return &contentImages{client: client, output: output, cachePath: cachePath}
+}
+
+// upload uploads one image unless the same content was uploaded before
+func (u *contentImages) upload(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	name := filepath.Base(path)
+	contentType, err := filetype.Image.ContentType(name, data)
+	if err != nil {
+		return "", err
+	}
+
+	// Reuse an earlier upload of the same file
+	if u.cache == nil && u.cachePath != "" {
+		if u.cache, err = localimages.LoadCache(u.cachePath); err != nil {
+			return "", err
+		}
+	}
+	site, hash := u.client.BaseURL(), localimages.HashData(data)
+	if u.cache != nil {
+		if url, ok := u.cache.Lookup(site, path, hash); ok {
+			return url, nil
+		}
+	}
+
+	image, err := u.client.UploadImage(bytes.NewReader(data), name, ghostapi.ImageUploadOptions{
+		Purpose:     "image",
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", err
+	}
+	u.output.PrintMessage(fmt.Sprintf("uploaded image: %s -> %s", path, image.URL))
+
+	if u.cache != nil {
+		u.cache.Store(site, path, hash, image.URL)
+		u.dirty = true
+	}
+	return image.URL, nil
+}
+
+// rewrite uploads local images referenced by content (Markdown or HTML) and
+// by the image fields of front matter and flags, rewriting them to uploaded URLs
+//
+// References in the file and its front matter are relative to the file's
+// directory; inline content and --feature-image are relative to the working
+// directory. Lexical content is returned unchanged.
+func (u *contentImages) rewrite(file, content string, format input.ContentFormat, fm *input.FrontMatter, flags *ContentMetaFlags) (string, error) {
+	content, err := u.rewriteRefs(file, content, format, fm, flags)
+
+	// Keep successful uploads cached even if a later one failed
+	if saveErr := u.save(); err == nil {
+		err = saveErr
+	}
+	return content, err
+}
+
+// rewriteRefs rewrites the references without saving the cache
+func (u *contentImages) rewriteRefs(file, content string, format input.ContentFormat, fm *input.FrontMatter, flags *ContentMetaFlags) (string, error) {
+	fileDir := "."
+	if file != "" {
+		fileDir = filepath.Dir(file)
+	}
+	inFile := &localimages.Rewriter{BaseDir: fileDir, Upload: u.upload}
+	inCwd := &localimages.Rewriter{BaseDir: ".", Upload: u.upload}
+
+	var err error
+	switch format {
+	case input.FormatMarkdown:
+		content, err = inFile.Markdown(content)
+	case input.FormatLexical:
+		// Lexical JSON is sent as-is
+	default:
+		content, err = inFile.HTML(content)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if fm != nil {
+		for _, field := range []*string{&fm.FeatureImage, &fm.OGImage, &fm.TwitterImage} {
+			if *field, err = inFile.Ref(*field); err != nil {
+				return "", err
+			}
+		}
+	}
+	if flags.FeatureImage, err = inCwd.Ref(flags.FeatureImage); err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
+
+// save writes the upload cache if anything was added
+func (u *contentImages) save() error {
+	if !u.dirty {
+		return nil
+	}
+	u.dirty = false
+	return u.cache.Save()
+}
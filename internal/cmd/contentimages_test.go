@@ -0,0 +1,53 @@
+/**
+ * contentimages_test.go
+ * Test code for automatic upload of local images in content
+ */
+
+package cmd
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+)
+
+// TestContentImages_RemoteReferencesUntouched verifies that content without local images needs no uploads
+func TestContentImages_RemoteReferencesUntouched(t *testing.T) {
+	client, err := ghostapi.NewClient("https://example.com", "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	images := newContentImages(context.Background(), client)
+	images.cachePath = filepath.Join(t.TempDir(), "uploads.json")
+
+	html := `<p><img src="https://cdn.example.com/a.png"></p>`
+	fm := &input.FrontMatter{FeatureImage: "https://cdn.example.com/cover.jpg"}
+	got, err := images.rewrite("", html, input.FormatHTML, fm, &ContentMetaFlags{})
+	if err != nil {
+		t.Fatalf("rewrite() error = %v", err)
+	}
+	if got != html || fm.FeatureImage != "https://cdn.example.com/cover.jpg" {
+		t.Errorf("rewrite() changed remote references: %s, %s", got, fm.FeatureImage)
+	}
+}
+
+// TestContentImages_MissingFeatureImage verifies that a missing front-matter image is reported
+func TestContentImages_MissingFeatureImage(t *testing.T) {
+	client, err := ghostapi.NewClient("https://example.com", "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	images := newContentImages(context.Background(), client)
+	images.cachePath = filepath.Join(t.TempDir(), "uploads.json")
+
+	file := filepath.Join(t.TempDir(), "post.md")
+	fm := &input.FrontMatter{FeatureImage: "./cover.jpg"}
+	_, err = images.rewrite(file, "", input.FormatMarkdown, fm, &ContentMetaFlags{})
+	if err == nil || !strings.Contains(err.Error(), "cover.jpg") {
+		t.Errorf("rewrite() error = %v; want image not found", err)
+	}
+}
@@ -0,0 +1,125 @@
+/**
+ * emails.go
+ * Newsletter email commands
+ *
+ * Provides functionality for listing sent newsletter emails and
+ * retrying failed deliveries. Retry requires confirmation.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+)
+
+// EmailsCmd is the newsletter email command
+type EmailsCmd struct {
+	List  EmailsListCmd  `cmd:"" help:"List recent newsletter emails"`
+	Retry EmailsRetryCmd `cmd:"" help:"Retry failed batches of a newsletter email"`
+}
+
+// EmailsListCmd is the command to retrieve recent newsletter emails
+type EmailsListCmd struct {
+	Limit  int    `help:"Number of emails to retrieve" short:"l" aliases:"max,n" default:"15"`
+	Page   int    `help:"Page number" short:"p" default:"1"`
+	Filter string `help:"Filter condition (e.g., status:failed)" aliases:"where,w"`
+}
+
+// Run executes the list subcommand of the emails command
+func (c *EmailsListCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get email list
+	response, err := client.ListEmails(ghostapi.EmailListOptions{
+		Limit:  c.Limit,
+		Page:   c.Page,
+		Filter: c.Filter,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list emails: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(response.Emails)
+	}
+
+	// Output in table format
+	headers := []string{"ID", "Post ID", "Status", "Subject", "Recipients", "Delivered", "Opened", "Failed", "Created"}
+	rows := make([][]string, len(response.Emails))
+	for i, email := range response.Emails {
+		rows[i] = []string{
+			email.ID,
+			email.PostID,
+			email.Status,
+			email.Subject,
+			fmt.Sprintf("%d", email.EmailCount),
+			fmt.Sprintf("%d", email.DeliveredCount),
+			fmt.Sprintf("%d", email.OpenedCount),
+			fmt.Sprintf("%d", email.FailedCount),
+			email.CreatedAt.Format("2006-01-02 15:04"),
+		}
+	}
+
+	return formatter.PrintTable(headers, rows)
+}
+
+// EmailsRetryCmd is the command to retry a failed newsletter email
+type EmailsRetryCmd struct {
+	ID string `arg:"" help:"Email ID"`
+}
+
+// Run executes the retry subcommand of the emails command
+func (c *EmailsRetryCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get email information for confirmation
+	email, err := client.GetEmail(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get email: %w", err)
+	}
+	if email.Status != "failed" {
+		return fmt.Errorf("email %s has status '%s'; only failed emails can be retried", c.ID, email.Status)
+	}
+
+	// Confirm retry (sends email to members)
+	action := fmt.Sprintf("retry sending email '%s' (ID: %s) to %d failed recipients", email.Subject, c.ID, email.FailedCount)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Retry email
+	retried, err := client.RetryEmail(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retry email: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(retried)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("retrying email %s (status: %s)", retried.ID, retried.Status))
+
+	return nil
+}
@@ -0,0 +1,35 @@
+/**
+ * emails_test.go
+ * Test code for newsletter email commands
+ */
+
+package cmd
+
+import (
+	"testing"
+)
+
+// TestEmailsCmd_StructExists verifies that email command structs exist
+func TestEmailsCmd_StructExists(t *testing.T) {
+	// Verify that email commands are defined
+	_ = &EmailsListCmd{}
+	_ = &EmailsRetryCmd{}
+	_ = &PostsEmailStatsCmd{}
+}
+
+// TestFormatEmailCount verifies counts are shown with their percentage
+func TestFormatEmailCount(t *testing.T) {
+	tests := []struct {
+		count, total int
+		want         string
+	}{
+		{98, 100, "98 (98.0%)"},
+		{1, 3, "1 (33.3%)"},
+		{0, 0, "0"},
+	}
+	for _, tt := range tests {
+		if got := formatEmailCount(tt.count, tt.total); got != tt.want {
+			t.Errorf("formatEmailCount(%d, %d) = %q; want %q", tt.count, tt.total, got, tt.want)
+		}
+	}
+}
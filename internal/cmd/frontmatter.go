@@ -0,0 +1,266 @@
+/**
+ * frontmatter.go
+ * Post/page metadata from front matter and flags
+ *
+ * Merges YAML front matter of content files with command-line flags
+ * (flags take precedence) and applies the result to posts and pages.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+)
+
+// ContentMetaFlags are post/page metadata flags that override front matter
+type ContentMetaFlags struct {
+	Slug            string   `help:"URL slug"`
+	Tags            []string `help:"Tag names (comma-separated, replaces existing tags)"`
+	Authors         []string `help:"Author emails or slugs (comma-separated, replaces existing authors)"`
+	Excerpt         string   `help:"Custom excerpt"`
+	FeatureImage    string   `help:"Feature image URL"`
+	PublishedAt     string   `help:"Publish date (YYYY-MM-DD, YYYY-MM-DD HH:MM, or RFC 3339)"`
+	Featured        *bool    `help:"Mark as featured" negatable:""`
+	Visibility      string   `help:"Visibility (public, members, paid, tiers)"`
+	MetaTitle       string   `help:"SEO meta title"`
+	MetaDescription string   `help:"SEO meta description"`
+	CanonicalURL    string   `help:"Canonical URL"`
+
+	NoUploadImages  bool `help:"Do not upload local images referenced by the content or feature image"`
+	MarkdownViaHTML bool `help:"Convert Markdown to HTML and let Ghost convert it (instead of converting to Lexical locally)"`
+}
+
+// contentMeta is the resolved metadata of a post or page
+type contentMeta struct {
+	input.FrontMatter
+	Published *time.Time
+}
+
+// resolveContentMeta merges front matter (may be nil) with flags
+//
+// title and status are the command's own flags; empty values fall back to front matter.
+func resolveContentMeta(fm *input.FrontMatter, title, status string, flags ContentMetaFlags) (*contentMeta, error) {
+	meta := &contentMeta{}
+	if fm != nil {
+		meta.FrontMatter = *fm
+	}
+	meta.CustomExcerpt = meta.ExcerptValue()
+
+	// Explicit flags override front matter
+	override := func(dst *string, value string) {
+		if value != "" {
+			*dst = value
+		}
+	}
+	override(&meta.Title, title)
+	override(&meta.Status, status)
+	override(&meta.Slug, flags.Slug)
+	override(&meta.CustomExcerpt, flags.Excerpt)
+	override(&meta.FeatureImage, flags.FeatureImage)
+	override(&meta.Visibility, flags.Visibility)
+	override(&meta.MetaTitle, flags.MetaTitle)
+	override(&meta.MetaDescription, flags.MetaDescription)
+	override(&meta.CanonicalURL, flags.CanonicalURL)
+	if flags.PublishedAt != "" {
+		meta.PublishedAt, meta.Date = flags.PublishedAt, ""
+	}
+	if len(flags.Tags) > 0 {
+		meta.Tags = splitFlagList(flags.Tags)
+	}
+	if len(flags.Authors) > 0 {
+		meta.Authors = splitFlagList(flags.Authors)
+	}
+	if flags.Featured != nil {
+		meta.Featured = flags.Featured
+	}
+
+	published, err := meta.PublishedTime()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse publish date: %w", err)
+	}
+	meta.Published = published
+
+	return meta, nil
+}
+
+// splitFlagList trims list flag values and drops empty items
+func splitFlagList(values []string) []string {
+	return input.SplitList(strings.Join(values, ","))
+}
+
+// contentFields points at the metadata fields shared by posts and pages
+type contentFields struct {
+	Title, Slug, Status, CustomExcerpt                        *string
+	FeatureImage, FeatureImageAlt, FeatureImageCaption        *string
+	Visibility, CustomTemplate, CodeinjectionHead             *string
+	CodeinjectionFoot, MetaTitle, MetaDescription             *string
+	OGImage, OGTitle, OGDescription                           *string
+	TwitterImage, TwitterTitle, TwitterDescription, Canonical *string
+	Featured                                                  **bool
+	PublishedAt                                               **time.Time
+	Tags                                                      *[]ghostapi.Tag
+	Authors                                                   *[]ghostapi.Author
+}
+
+// postFields returns the metadata fields of a post
+func postFields(p *ghostapi.Post) contentFields {
+	return contentFields{
+		Title: &p.Title, Slug: &p.Slug, Status: &p.Status, CustomExcerpt: &p.CustomExcerpt,
+		FeatureImage: &p.FeatureImage, FeatureImageAlt: &p.FeatureImageAlt, FeatureImageCaption: &p.FeatureImageCaption,
+		Visibility: &p.Visibility, CustomTemplate: &p.CustomTemplate, CodeinjectionHead: &p.CodeinjectionHead,
+		CodeinjectionFoot: &p.CodeinjectionFoot, MetaTitle: &p.MetaTitle, MetaDescription: &p.MetaDescription,
+		OGImage: &p.OGImage, OGTitle: &p.OGTitle, OGDescription: &p.OGDescription,
+		TwitterImage: &p.TwitterImage, TwitterTitle: &p.TwitterTitle, TwitterDescription: &p.TwitterDescription, Canonical: &p.CanonicalURL,
+		Featured: &p.Featured, PublishedAt: &p.PublishedAt, Tags: &p.Tags, Authors: &p.Authors,
+	}
+}
+
+// pageFields returns the metadata fields of a page
+func pageFields(p *ghostapi.Page) contentFields {
+	return contentFields{
+		Title: &p.Title, Slug: &p.Slug, Status: &p.Status, CustomExcerpt: &p.CustomExcerpt,
+		FeatureImage: &p.FeatureImage, FeatureImageAlt: &p.FeatureImageAlt, FeatureImageCaption: &p.FeatureImageCaption,
+		Visibility: &p.Visibility, CustomTemplate: &p.CustomTemplate, CodeinjectionHead: &p.CodeinjectionHead,
+		CodeinjectionFoot: &p.CodeinjectionFoot, MetaTitle: &p.MetaTitle, MetaDescription: &p.MetaDescription,
+		OGImage: &p.OGImage, OGTitle: &p.OGTitle, OGDescription: &p.OGDescription,
+		TwitterImage: &p.TwitterImage, TwitterTitle: &p.TwitterTitle, TwitterDescription: &p.TwitterDescription, Canonical: &p.CanonicalURL,
+		Featured: &p.Featured, PublishedAt: &p.PublishedAt, Tags: &p.Tags, Authors: &p.Authors,
+	}
+}
+
+// apply sets every metadata field that has a value, leaving the others untouched
+func (m *contentMeta) apply(f contentFields) {
+	set := func(dst *string, value string) {
+		if value != "" {
+			*dst = value
+		}
+	}
+	set(f.Title, m.Title)
+	set(f.Slug, m.Slug)
+	set(f.Status, m.Status)
+	set(f.CustomExcerpt, m.CustomExcerpt)
+	set(f.FeatureImage, m.FeatureImage)
+	set(f.FeatureImageAlt, m.FeatureImageAlt)
+	set(f.FeatureImageCaption, m.FeatureImageCaption)
+	set(f.Visibility, m.Visibility)
+	set(f.CustomTemplate, m.CustomTemplate)
+	set(f.CodeinjectionHead, m.CodeinjectionHead)
+	set(f.CodeinjectionFoot, m.CodeinjectionFoot)
+	set(f.MetaTitle, m.MetaTitle)
+	set(f.MetaDescription, m.MetaDescription)
+	set(f.OGImage, m.OGImage)
+	set(f.OGTitle, m.OGTitle)
+	set(f.OGDescription, m.OGDescription)
+	set(f.TwitterImage, m.TwitterImage)
+	set(f.TwitterTitle, m.TwitterTitle)
+	set(f.TwitterDescription, m.TwitterDescription)
+	set(f.Canonical, m.CanonicalURL)
+
+	if m.Featured != nil {
+		featured := *m.Featured
+		*f.Featured = &featured
+	}
+	if m.Published != nil {
+		*f.PublishedAt = m.Published
+	}
+
+	// Ghost matches tags by name (creating missing ones) and authors by email or slug
+	if len(m.Tags) > 0 {
+		tags := make([]ghostapi.Tag, len(m.Tags))
+		for i, name := range m.Tags {
+			tags[i] = ghostapi.Tag{Name: name}
+		}
+		*f.Tags = tags
+	}
+	if len(m.Authors) > 0 {
+		authors := make([]ghostapi.Author, len(m.Authors))
+		for i, author := range m.Authors {
+			if strings.Contains(author, "@") {
+				authors[i] = ghostapi.Author{Email: author}
+			} else {
+				authors[i] = ghostapi.Author{Slug: author}
+			}
+		}
+		*f.Authors = authors
+	}
+}
+
+// replace applies a complete front matter (edit and sync): optional fields
+// that are left out are cleared instead of kept
+//
+// Title, slug, status, visibility, publish date, and authors cannot be
+// cleared and keep their values. Returns the JSON names of the cleared
+// fields, which have to be sent explicitly (ghostapi.CreateOptions.Clear).
+func (m *contentMeta) replace(f contentFields) []string {
+	m.apply(f)
+
+	var cleared []string
+	clear := func(name string, dst *string, value string) {
+		if value == "" {
+			*dst = ""
+			cleared = append(cleared, name)
+		}
+	}
+	clear("custom_excerpt", f.CustomExcerpt, m.CustomExcerpt)
+	clear("feature_image", f.FeatureImage, m.FeatureImage)
+	clear("feature_image_alt", f.FeatureImageAlt, m.FeatureImageAlt)
+	clear("feature_image_caption", f.FeatureImageCaption, m.FeatureImageCaption)
+	clear("custom_template", f.CustomTemplate, m.CustomTemplate)
+	clear("codeinjection_head", f.CodeinjectionHead, m.CodeinjectionHead)
+	clear("codeinjection_foot", f.CodeinjectionFoot, m.CodeinjectionFoot)
+	clear("meta_title", f.MetaTitle, m.MetaTitle)
+	clear("meta_description", f.MetaDescription, m.MetaDescription)
+	clear("og_image", f.OGImage, m.OGImage)
+	clear("og_title", f.OGTitle, m.OGTitle)
+	clear("og_description", f.OGDescription, m.OGDescription)
+	clear("twitter_image", f.TwitterImage, m.TwitterImage)
+	clear("twitter_title", f.TwitterTitle, m.TwitterTitle)
+	clear("twitter_description", f.TwitterDescription, m.TwitterDescription)
+	clear("canonical_url", f.Canonical, m.CanonicalURL)
+
+	// Front matter only lists featured when it is true
+	if m.Featured == nil {
+		featured := false
+		*f.Featured = &featured
+	}
+	if len(m.Tags) == 0 {
+		*f.Tags = nil
+		cleared = append(cleared, "tags")
+	}
+	return cleared
+}
+
+// frontMatter returns the metadata fields as front matter (the reverse of apply)
+func (f contentFields) frontMatter() *input.FrontMatter {
+	fm := &input.FrontMatter{
+		Title: *f.Title, Slug: *f.Slug, Status: *f.Status, CustomExcerpt: *f.CustomExcerpt,
+		FeatureImage: *f.FeatureImage, FeatureImageAlt: *f.FeatureImageAlt, FeatureImageCaption: *f.FeatureImageCaption,
+		Visibility: *f.Visibility, CustomTemplate: *f.CustomTemplate, CodeinjectionHead: *f.CodeinjectionHead,
+		CodeinjectionFoot: *f.CodeinjectionFoot, MetaTitle: *f.MetaTitle, MetaDescription: *f.MetaDescription,
+		OGImage: *f.OGImage, OGTitle: *f.OGTitle, OGDescription: *f.OGDescription,
+		TwitterImage: *f.TwitterImage, TwitterTitle: *f.TwitterTitle, TwitterDescription: *f.TwitterDescription, CanonicalURL: *f.Canonical,
+	}
+	if *f.Featured != nil && **f.Featured {
+		featured := true
+		fm.Featured = &featured
+	}
+	if *f.PublishedAt != nil {
+		fm.PublishedAt = (*f.PublishedAt).Format(time.RFC3339)
+	}
+	for _, tag := range *f.Tags {
+		fm.Tags = append(fm.Tags, tag.Name)
+	}
+	for _, author := range *f.Authors {
+		if author.Email != "" {
+			fm.Authors = append(fm.Authors, author.Email)
+		} else {
+			fm.Authors = append(fm.Authors, author.Slug)
+		}
+	}
+	return fm
+}
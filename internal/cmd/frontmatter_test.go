@@ -0,0 +1,125 @@
+/**
+ * frontmatter_test.go
+ * Test code for merging front matter with post/page flags
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+)
+
+// TestPostsCreateCmd_ParseMetaFlags verifies the metadata flags on posts create
+func TestPostsCreateCmd_ParseMetaFlags(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	// Title is no longer required on the command line (it can come from front matter)
+	_, err = parser.Parse([]string{"posts", "create", "--tags", "News,Go", "--no-featured", "--slug", "hello"})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	flags := cli.Posts.Create.ContentMetaFlags
+	if len(flags.Tags) != 2 || flags.Slug != "hello" {
+		t.Errorf("ContentMetaFlags = %+v", flags)
+	}
+	if flags.Featured == nil || *flags.Featured {
+		t.Errorf("Featured = %v; want false", flags.Featured)
+	}
+}
+
+// TestResolveContentMeta_FlagsOverrideFrontMatter verifies precedence
+func TestResolveContentMeta_FlagsOverrideFrontMatter(t *testing.T) {
+	featured := true
+	fm := &input.FrontMatter{
+		Title:       "From Front Matter",
+		Slug:        "fm-slug",
+		Status:      "published",
+		Excerpt:     "fm excerpt",
+		Tags:        input.StringList{"A", "B"},
+		Featured:    &featured,
+		PublishedAt: "2024-03-01 10:00",
+	}
+	flags := ContentMetaFlags{Slug: "flag-slug", Tags: []string{"C"}}
+
+	meta, err := resolveContentMeta(fm, "From Flag", "", flags)
+	if err != nil {
+		t.Fatalf("resolveContentMeta() error = %v", err)
+	}
+	if meta.Title != "From Flag" || meta.Slug != "flag-slug" || meta.Status != "published" || meta.CustomExcerpt != "fm excerpt" {
+		t.Errorf("meta = %+v", meta)
+	}
+	if len(meta.Tags) != 1 || meta.Tags[0] != "C" {
+		t.Errorf("Tags = %v; want [C]", meta.Tags)
+	}
+	if meta.Published == nil {
+		t.Error("Published = nil; want date from front matter")
+	}
+}
+
+// TestResolveContentMeta_InvalidDate verifies that bad dates are reported
+func TestResolveContentMeta_InvalidDate(t *testing.T) {
+	if _, err := resolveContentMeta(nil, "", "", ContentMetaFlags{PublishedAt: "tomorrow"}); err == nil {
+		t.Error("resolveContentMeta() error = nil; want error")
+	}
+}
+
+// TestContentMeta_Apply verifies that only set fields are applied
+func TestContentMeta_Apply(t *testing.T) {
+	meta, err := resolveContentMeta(&input.FrontMatter{
+		Title:   "New",
+		Tags:    input.StringList{"News"},
+		Authors: input.StringList{"jane@example.com", "bob"},
+	}, "", "", ContentMetaFlags{})
+	if err != nil {
+		t.Fatalf("resolveContentMeta() error = %v", err)
+	}
+
+	post := &ghostapi.Post{Title: "Old", Slug: "keep", Status: "draft"}
+	meta.apply(postFields(post))
+
+	if post.Title != "New" || post.Slug != "keep" || post.Status != "draft" {
+		t.Errorf("post = %+v", post)
+	}
+	if len(post.Tags) != 1 || post.Tags[0].Name != "News" {
+		t.Errorf("Tags = %+v", post.Tags)
+	}
+	if len(post.Authors) != 2 || post.Authors[0].Email != "jane@example.com" || post.Authors[1].Slug != "bob" {
+		t.Errorf("Authors = %+v", post.Authors)
+	}
+
+	page := &ghostapi.Page{}
+	meta.apply(pageFields(page))
+	if page.Title != "New" || len(page.Tags) != 1 {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+// TestContentMeta_ApplyNotFeatured verifies that --no-featured is sent in the request body
+func TestContentMeta_ApplyNotFeatured(t *testing.T) {
+	notFeatured := false
+	meta, err := resolveContentMeta(nil, "", "", ContentMetaFlags{Featured: &notFeatured})
+	if err != nil {
+		t.Fatalf("resolveContentMeta() error = %v", err)
+	}
+	post := &ghostapi.Post{ID: "1"}
+	meta.apply(postFields(post))
+
+	body, err := json.Marshal(post)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(body), `"featured":false`) {
+		t.Errorf("request body = %s; want featured:false", body)
+	}
+}
@@ -2,72 +2,240 @@
  * images.go
  * Image management commands
  *
- * Provides functionality for uploading Ghost images.
+ * Provides functionality for uploading Ghost images, one at a time or in
+ * batches (directories and glob patterns) with optional local resizing,
+ * recompression, and metadata removal.
  */
 
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
+	"github.com/mtane0412/ghocli/internal/filetype"
 	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/imageproc"
 	"github.com/mtane0412/ghocli/internal/outfmt"
 )
 
 // ImagesCmd is the image management command
 type ImagesCmd struct {
-	Upload ImagesUploadCmd `cmd:"" help:"Upload an image"`
+	Upload ImagesUploadCmd `cmd:"" help:"Upload images (files, directories, or glob patterns)"`
 }
 
-// ImagesUploadCmd is the command to upload image
+// ImagesUploadCmd is the command to upload images
 type ImagesUploadCmd struct {
-	File    string `arg:"" help:"Path to image file" type:"existingfile"`
-	Purpose string `help:"Image purpose (image, profile_image, icon)" short:"p" default:"image"`
-	Ref     string `help:"Reference ID for the image" short:"r"`
+	Paths   []string `arg:"" name:"path" help:"Image files, directories, or glob patterns (e.g., 'img/*.png')"`
+	Purpose string   `help:"Image purpose (image, profile_image, icon)" short:"p" default:"image"`
+	Ref     string   `help:"Reference ID for the image (single image only)" short:"r"`
+
+	MaxWidth      int  `help:"Downscale JPEG/PNG images wider than this many pixels"`
+	Recompress    bool `help:"Re-encode JPEG/PNG images to reduce their size"`
+	Quality       int  `help:"JPEG quality when re-encoding" default:"85"`
+	StripMetadata bool `help:"Remove EXIF, GPS, and other metadata from JPEG/PNG images"`
+	Concurrency   int  `help:"Number of parallel uploads" default:"4"`
+}
+
+// imageUploadResult is the outcome of uploading one image
+type imageUploadResult struct {
+	Path string
+	URL  string
+	Err  error
 }
 
 // Run executes the upload subcommand of the images command
 func (c *ImagesUploadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Expand directories and glob patterns
+	files, batch, err := expandImagePaths(c.Paths)
+	if err != nil {
+		return err
+	}
+	if c.Ref != "" && len(files) > 1 {
+		return fmt.Errorf("--ref can only be used with a single image")
+	}
+
 	// Get API client
 	client, err := getAPIClient(root)
 	if err != nil {
 		return err
 	}
 
-	// Open file
-	file, err := os.Open(c.File)
+	// Upload images concurrently
+	results := make([]imageUploadResult, len(files))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(1, c.Concurrency); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				image, err := c.upload(client, files[i])
+				results[i] = imageUploadResult{Path: files[i], Err: err}
+				if err == nil {
+					results[i].URL = image.URL
+				}
+			}
+		}()
+	}
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// A single file keeps the simple output
+	if !batch {
+		if results[0].Err != nil {
+			return fmt.Errorf("failed to upload image: %w", results[0].Err)
+		}
+		if root.JSON {
+			return formatter.Print(ghostapi.Image{URL: results[0].URL, Ref: c.Ref})
+		}
+		formatter.PrintMessage(fmt.Sprintf("uploaded image: %s", results[0].URL))
+		return nil
+	}
+
+	// Output local path -> URL map if JSON format (failures go to stderr)
+	failed := 0
+	uploaded := map[string]string{}
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+		uploaded[result.Path] = result.URL
+	}
+	if root.JSON {
+		for _, result := range results {
+			if result.Err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", result.Path, result.Err)
+			}
+		}
+		if err := formatter.Print(uploaded); err != nil {
+			return err
+		}
+	} else {
+		headers := []string{"Path", "URL"}
+		rows := make([][]string, len(results))
+		for i, result := range results {
+			url := result.URL
+			if result.Err != nil {
+				url = "error: " + result.Err.Error()
+			}
+			rows[i] = []string{result.Path, url}
+		}
+		if err := formatter.PrintTable(headers, rows); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("failed to upload %d of %d images", failed, len(results))}
+	}
+	return nil
+}
+
+// upload checks, pre-processes, and uploads one image
+func (c *ImagesUploadCmd) upload(client *ghostapi.Client, path string) (*ghostapi.Image, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to open file: %w", err)
+		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
-	defer file.Close()
 
-	// Get filename
-	fileInfo, err := file.Stat()
+	name := filepath.Base(path)
+	contentType, err := filetype.Image.ContentType(name, data)
 	if err != nil {
-		return fmt.Errorf("failed to get file information: %w", err)
+		return nil, err
 	}
 
-	// Upload image
-	image, err := client.UploadImage(file, fileInfo.Name(), ghostapi.ImageUploadOptions{
-		Purpose: c.Purpose,
-		Ref:     c.Ref,
+	data, err = imageproc.Process(data, imageproc.Options{
+		MaxWidth:      c.MaxWidth,
+		Recompress:    c.Recompress,
+		Quality:       c.Quality,
+		StripMetadata: c.StripMetadata,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to upload image: %w", err)
+		return nil, err
 	}
 
-	// Create output formatter
-	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+	return client.UploadImage(bytes.NewReader(data), name, ghostapi.ImageUploadOptions{
+		Purpose:     c.Purpose,
+		Ref:         c.Ref,
+		ContentType: contentType,
+	})
+}
 
-	// Output as-is if JSON format
-	if root.JSON {
-		return formatter.Print(image)
+// expandImagePaths expands directories (recursively) and glob patterns into image files
+//
+// batch reports whether the arguments named more than a single plain file.
+func expandImagePaths(args []string) (files []string, batch bool, err error) {
+	seen := map[string]bool{}
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			files = append(files, path)
+		}
 	}
 
-	// Show success message and image URL
-	formatter.PrintMessage(fmt.Sprintf("uploaded image: %s", image.URL))
+	for _, arg := range args {
+		// Glob patterns (quoted so the shell did not expand them)
+		if strings.ContainsAny(arg, "*?[") {
+			batch = true
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid pattern %q: %w", arg, err)
+			}
+			for _, match := range matches {
+				if info, err := os.Stat(match); err == nil && !info.IsDir() {
+					add(match)
+				}
+			}
+			continue
+		}
 
-	return nil
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to open %s: %w", arg, err)
+		}
+		if !info.IsDir() {
+			add(arg)
+			continue
+		}
+
+		// Directories contribute the images they contain
+		batch = true
+		err = filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != arg && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if _, ok := filetype.Image.Extensions[strings.ToLower(filepath.Ext(path))]; ok {
+				add(path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read directory %s: %w", arg, err)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, false, fmt.Errorf("no images found")
+	}
+	return files, batch || len(files) > 1, nil
 }
@@ -0,0 +1,67 @@
+/**
+ * images_test.go
+ * Test code for image management commands
+ */
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/kong"
+)
+
+// TestImagesUploadCmd_ParseFlags verifies multiple paths and pre-processing flags
+func TestImagesUploadCmd_ParseFlags(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	dir := t.TempDir()
+	if _, err := parser.Parse([]string{"images", "upload", dir, "b.png", "--max-width", "1600", "--strip-metadata"}); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	upload := cli.Images.Upload
+	if !reflect.DeepEqual(upload.Paths, []string{dir, "b.png"}) || upload.MaxWidth != 1600 || !upload.StripMetadata || upload.Quality != 85 {
+		t.Errorf("Upload = %+v", upload)
+	}
+}
+
+// TestExpandImagePaths verifies expanding files, directories, and globs
+func TestExpandImagePaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.jpg", "b.PNG", "notes.txt", "sub/c.webp", ".git/d.png"} {
+		path := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		os.WriteFile(path, []byte("x"), 0644)
+	}
+
+	// Single file
+	files, batch, err := expandImagePaths([]string{filepath.Join(dir, "a.jpg")})
+	if err != nil || batch || len(files) != 1 {
+		t.Errorf("single file = %v, %v, %v", files, batch, err)
+	}
+
+	// Directory (recursive, images only, hidden directories skipped)
+	files, batch, err = expandImagePaths([]string{dir})
+	want := []string{filepath.Join(dir, "a.jpg"), filepath.Join(dir, "b.PNG"), filepath.Join(dir, "sub", "c.webp")}
+	if err != nil || !batch || !reflect.DeepEqual(files, want) {
+		t.Errorf("directory = %v, %v, %v; want %v", files, batch, err, want)
+	}
+
+	// Glob with a duplicate
+	files, _, err = expandImagePaths([]string{filepath.Join(dir, "*.jpg"), filepath.Join(dir, "a.jpg")})
+	if err != nil || len(files) != 1 {
+		t.Errorf("glob = %v, %v; want a.jpg once", files, err)
+	}
+
+	// Nothing matched
+	if _, _, err := expandImagePaths([]string{filepath.Join(dir, "*.gif")}); err == nil {
+		t.Error("expected error when no images match")
+	}
+}
@@ -0,0 +1,164 @@
+/**
+ * invites.go
+ * Staff invitation and role commands
+ *
+ * Provides functionality for listing and revoking staff invitations
+ * and listing staff roles. Revoking requires confirmation.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+)
+
+// InvitesCmd is the staff invitation command
+type InvitesCmd struct {
+	List   InvitesListCmd   `cmd:"" help:"List pending invitations"`
+	Revoke InvitesRevokeCmd `cmd:"" help:"Revoke an invitation"`
+}
+
+// InvitesListCmd is the command to list pending invitations
+type InvitesListCmd struct{}
+
+// Run executes the list subcommand of the invites command
+func (c *InvitesListCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get invitations and roles (to show role names)
+	invites, err := client.ListInvites()
+	if err != nil {
+		return fmt.Errorf("failed to list invites: %w", err)
+	}
+	roles, err := client.ListRoles(false)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(invites)
+	}
+
+	// Output in table format
+	headers := []string{"ID", "Email", "Role", "Status", "Expires"}
+	rows := make([][]string, len(invites))
+	for i, invite := range invites {
+		roleName := invite.RoleID
+		if role := matchRole(roles, invite.RoleID); role != nil {
+			roleName = role.Name
+		}
+		rows[i] = []string{
+			invite.ID,
+			invite.Email,
+			roleName,
+			invite.Status,
+			invite.ExpiresAt().Format("2006-01-02 15:04"),
+		}
+	}
+
+	return formatter.PrintTable(headers, rows)
+}
+
+// InvitesRevokeCmd is the command to revoke an invitation
+type InvitesRevokeCmd struct {
+	ID string `arg:"" help:"Invite ID or invited email address"`
+}
+
+// Run executes the revoke subcommand of the invites command
+func (c *InvitesRevokeCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Find the invitation
+	invites, err := client.ListInvites()
+	if err != nil {
+		return fmt.Errorf("failed to list invites: %w", err)
+	}
+	var invite *ghostapi.Invite
+	for i := range invites {
+		if invites[i].ID == c.ID || invites[i].Email == c.ID {
+			invite = &invites[i]
+			break
+		}
+	}
+	if invite == nil {
+		return fmt.Errorf("invite not found: %s", c.ID)
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("revoke invitation for %s (ID: %s)", invite.Email, invite.ID)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Revoke invitation
+	if err := client.DeleteInvite(invite.ID); err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("revoked invitation for %s", invite.Email))
+
+	return nil
+}
+
+// RolesCmd is the staff role command
+type RolesCmd struct {
+	List RolesListCmd `cmd:"" default:"1" help:"List staff roles"`
+}
+
+// RolesListCmd is the command to list staff roles
+type RolesListCmd struct {
+	Assignable bool `help:"Only show roles you can assign"`
+}
+
+// Run executes the list subcommand of the roles command
+func (c *RolesListCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get roles
+	roles, err := client.ListRoles(c.Assignable)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(roles)
+	}
+
+	// Output in table format
+	headers := []string{"ID", "Name", "Description"}
+	rows := make([][]string, len(roles))
+	for i, role := range roles {
+		rows[i] = []string{role.ID, role.Name, role.Description}
+	}
+
+	return formatter.PrintTable(headers, rows)
+}
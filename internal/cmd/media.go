@@ -0,0 +1,189 @@
+/**
+ * media.go
+ * Media and file upload commands
+ *
+ * Provides uploads of video/audio (with an optional thumbnail) and of
+ * attachments such as PDFs. File types are checked locally against the
+ * types Ghost accepts before anything is uploaded.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mtane0412/ghocli/internal/filetype"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+)
+
+// MediaCmd is the media management command
+type MediaCmd struct {
+	Upload MediaUploadCmd `cmd:"" help:"Upload a video or audio file"`
+}
+
+// MediaUploadCmd is the command to upload media
+type MediaUploadCmd struct {
+	File      string `arg:"" help:"Path to video or audio file (mp4, webm, ogv, mp3, wav, ogg, m4a)" type:"existingfile"`
+	Thumbnail string `help:"Thumbnail image for the media" type:"existingfile"`
+	Ref       string `help:"Reference ID for the media" short:"r"`
+	HTML      bool   `help:"Print an HTML snippet for embedding instead of the URL"`
+}
+
+// Run executes the upload subcommand of the media command
+func (c *MediaUploadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Open and check files before anything is uploaded
+	file, contentType, err := openUpload(c.File, filetype.Media)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	opts := ghostapi.MediaUploadOptions{ContentType: contentType, Ref: c.Ref}
+	if c.Thumbnail != "" {
+		thumbnail, thumbnailType, err := openUpload(c.Thumbnail, filetype.Image)
+		if err != nil {
+			return err
+		}
+		defer thumbnail.Close()
+		opts.Thumbnail = thumbnail
+		opts.ThumbnailName = filepath.Base(c.Thumbnail)
+		opts.ThumbnailContentType = thumbnailType
+	}
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Upload media
+	media, err := client.UploadMedia(file, filepath.Base(c.File), opts)
+	if err != nil {
+		return fmt.Errorf("failed to upload media: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(media)
+	}
+
+	if c.HTML {
+		formatter.PrintMessage(mediaEmbedHTML(media, contentType))
+		return nil
+	}
+
+	// Show success message and media URL
+	formatter.PrintMessage(fmt.Sprintf("uploaded media: %s", media.URL))
+	if media.ThumbnailURL != "" {
+		formatter.PrintMessage(fmt.Sprintf("thumbnail: %s", media.ThumbnailURL))
+	}
+
+	return nil
+}
+
+// FilesCmd is the file management command
+type FilesCmd struct {
+	Upload FilesUploadCmd `cmd:"" help:"Upload a file (PDF, document, etc.)"`
+}
+
+// FilesUploadCmd is the command to upload a file
+type FilesUploadCmd struct {
+	File string `arg:"" help:"Path to file" type:"existingfile"`
+	Ref  string `help:"Reference ID for the file" short:"r"`
+	HTML bool   `help:"Print an HTML link for embedding instead of the URL"`
+}
+
+// Run executes the upload subcommand of the files command
+func (c *FilesUploadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Open and check file before anything is uploaded
+	file, contentType, err := openUpload(c.File, filetype.File)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Upload file
+	uploaded, err := client.UploadFile(file, filepath.Base(c.File), ghostapi.FileUploadOptions{
+		ContentType: contentType,
+		Ref:         c.Ref,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(uploaded)
+	}
+
+	if c.HTML {
+		formatter.PrintMessage(fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(uploaded.URL), html.EscapeString(filepath.Base(c.File))))
+		return nil
+	}
+
+	// Show success message and file URL
+	formatter.PrintMessage(fmt.Sprintf("uploaded file: %s", uploaded.URL))
+
+	return nil
+}
+
+// openUpload opens a file for upload and checks its type
+//
+// Returns the file (positioned at the start) and its content type.
+func openUpload(path string, kind filetype.Kind) (*os.File, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open file: %w", err)
+	}
+
+	// Read the start of the file for content sniffing
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		file.Close()
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	contentType, err := kind.ContentType(filepath.Base(path), head[:n])
+	if err != nil {
+		file.Close()
+		return nil, "", err
+	}
+	return file, contentType, nil
+}
+
+// mediaEmbedHTML returns an HTML element that plays the uploaded media
+func mediaEmbedHTML(media *ghostapi.Media, contentType string) string {
+	src := html.EscapeString(media.URL)
+	if strings.HasPrefix(contentType, "audio/") {
+		return fmt.Sprintf(`<audio src="%s" controls preload="metadata"></audio>`, src)
+	}
+	poster := ""
+	if media.ThumbnailURL != "" {
+		poster = fmt.Sprintf(` poster="%s"`, html.EscapeString(media.ThumbnailURL))
+	}
+	return fmt.Sprintf(`<video src="%s"%s controls preload="metadata"></video>`, src, poster)
+}
@@ -0,0 +1,73 @@
+/**
+ * media_test.go
+ * Test code for media and file upload commands
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mtane0412/ghocli/internal/filetype"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+)
+
+// TestMediaCmd_StructExists verifies that media and files command structs exist
+func TestMediaCmd_StructExists(t *testing.T) {
+	// Verify that upload commands are defined
+	_ = &MediaUploadCmd{}
+	_ = &FilesUploadCmd{}
+}
+
+// TestFilesUploadCmd_RejectsUnsupportedTypeLocally verifies the check runs before any API access
+func TestFilesUploadCmd_RejectsUnsupportedTypeLocally(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "setup.exe")
+	if err := os.WriteFile(path, []byte("MZ"), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	cmd := &FilesUploadCmd{File: path}
+	err := cmd.Run(context.Background(), &RootFlags{})
+	if err == nil || !strings.Contains(err.Error(), "unsupported file type .exe") {
+		t.Errorf("err = %v; want unsupported file type", err)
+	}
+}
+
+// TestOpenUpload_KeepsFileAtStart verifies that sniffing does not consume the file
+func TestOpenUpload_KeepsFileAtStart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "episode.mp3")
+	if err := os.WriteFile(path, []byte("ID3\x03\x00audio"), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	file, contentType, err := openUpload(path, filetype.Media)
+	if err != nil {
+		t.Fatalf("openUpload() error: %v", err)
+	}
+	defer file.Close()
+
+	if contentType != "audio/mpeg" {
+		t.Errorf("contentType = %q; want %q", contentType, "audio/mpeg")
+	}
+	data := make([]byte, 3)
+	file.Read(data)
+	if string(data) != "ID3" {
+		t.Errorf("file starts with %q; want %q", data, "ID3")
+	}
+}
+
+// TestMediaEmbedHTML verifies embed snippets for video and audio
+func TestMediaEmbedHTML(t *testing.T) {
+	video := mediaEmbedHTML(&ghostapi.Media{URL: "https://x/c.mp4", ThumbnailURL: "https://x/t.jpg"}, "video/mp4")
+	if video != `<video src="https://x/c.mp4" poster="https://x/t.jpg" controls preload="metadata"></video>` {
+		t.Errorf("video = %s", video)
+	}
+	audio := mediaEmbedHTML(&ghostapi.Media{URL: "https://x/a.mp3"}, "audio/mpeg")
+	if audio != `<audio src="https://x/a.mp3" controls preload="metadata"></audio>` {
+		t.Errorf("audio = %s", audio)
+	}
+}
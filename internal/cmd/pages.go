@@ -16,7 +16,6 @@ import (
 	"github.com/mtane0412/ghocli/internal/fields"
 	"github.com/mtane0412/ghocli/internal/ghostapi"
 	"github.com/mtane0412/ghocli/internal/input"
-	"github.com/mtane0412/ghocli/internal/markdown"
 	"github.com/mtane0412/ghocli/internal/outfmt"
 )
 
@@ -50,6 +49,12 @@ type PagesCmd struct {
 
 	// Phase 8.3: Copy
 	Copy PagesCopyCmd `cmd:"" help:"Copy a page"`
+
+	// Content diff
+	Diff PagesDiffCmd `cmd:"" help:"Show what update --file would change"`
+
+	// Editor
+	Edit PagesEditCmd `cmd:"" help:"Edit a page as Markdown in $VISUAL/$EDITOR"`
 }
 
 // PagesListCmd is the command to retrieve page list
@@ -209,7 +214,7 @@ func (c *PagesInfoCmd) Run(ctx context.Context, root *RootFlags) error {
 	}
 
 	// Add featured (if true)
-	if page.Featured {
+	if page.Featured != nil && *page.Featured {
 		rows = append(rows, []string{"featured", "true"})
 	}
 
@@ -235,12 +240,14 @@ func (c *PagesInfoCmd) Run(ctx context.Context, root *RootFlags) error {
 
 // PagesCreateCmd is the command to create page
 type PagesCreateCmd struct {
-	Title    string `help:"Page title" short:"t" required:""`
+	Title    string `help:"Page title (required unless set in front matter)" short:"t"`
 	HTML     string `help:"Page content (HTML)" short:"c"`
 	Markdown string `help:"Page content (Markdown)" short:"m"`
 	Lexical  string `help:"Page content (Lexical JSON)" short:"x"`
-	File     string `help:"Read content from file (auto-detect format)" type:"existingfile"`
-	Status   string `help:"Page status (draft, published)" default:"draft"`
+	File     string `help:"Read content from file (auto-detect format, YAML front matter supported)" type:"existingfile"`
+	Status   string `help:"Page status (draft, published; default: draft)"`
+
+	ContentMetaFlags `embed:""`
 }
 
 // Run executes the create subcommand of the pages command
@@ -251,56 +258,30 @@ func (c *PagesCreateCmd) Run(ctx context.Context, root *RootFlags) error {
 		return err
 	}
 
-	// Determine content and format
-	var htmlContent string
-	var format input.ContentFormat
-
-	// Auto-detect format when file is specified
-	if c.File != "" {
-		fileContent, detectedFormat, err := input.ReadContentWithFormat(c.File, "")
-		if err != nil {
-			return fmt.Errorf("failed to read file: %w", err)
-		}
-		format = detectedFormat
-
-		// Process according to format
-		switch format {
-		case input.FormatMarkdown:
-			// Convert Markdown to HTML
-			htmlContent, err = markdown.ConvertToHTML(fileContent)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		case input.FormatHTML:
-			// Use HTML as-is
-			htmlContent = fileContent
-		case input.FormatLexical:
-			// Use Lexical JSON as-is (set to c.Lexical)
-			c.Lexical = fileContent
-		default:
-			// Treat as HTML for unknown formats
-			htmlContent = fileContent
-		}
-	} else {
-		// Process inline content
-		htmlContent = c.HTML
+	// Read content (Markdown is converted to Lexical locally)
+	content, err := loadContent(ctx, client, c.File, c.HTML, c.Markdown, c.Lexical, &c.ContentMetaFlags)
+	if err != nil {
+		return err
+	}
+	htmlContent, frontMatter := content.HTML, content.FrontMatter
+	c.Lexical = content.Lexical
 
-		// Convert Markdown to HTML if Markdown flag is specified
-		if c.Markdown != "" {
-			htmlContent, err = markdown.ConvertToHTML(c.Markdown)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		}
+	// Merge front matter with flags (flags take precedence)
+	meta, err := resolveContentMeta(frontMatter, c.Title, c.Status, c.ContentMetaFlags)
+	if err != nil {
+		return err
+	}
+	if meta.Title == "" {
+		return fmt.Errorf("title is required (--title or front matter)")
 	}
 
 	// Create new page
 	newPage := &ghostapi.Page{
-		Title:   c.Title,
 		HTML:    htmlContent,
 		Lexical: c.Lexical,
-		Status:  c.Status,
+		Status:  "draft",
 	}
+	meta.apply(pageFields(newPage))
 
 	// Automatically apply source=html when HTML content is specified
 	var createdPage *ghostapi.Page
@@ -342,8 +323,10 @@ type PagesUpdateCmd struct {
 	HTML     string `help:"Page content (HTML)" short:"c"`
 	Markdown string `help:"Page content (Markdown)" short:"m"`
 	Lexical  string `help:"Page content (Lexical JSON)" short:"x"`
-	File     string `help:"Read content from file (auto-detect format)" type:"existingfile"`
+	File     string `help:"Read content from file (auto-detect format, YAML front matter supported)" type:"existingfile"`
 	Status   string `help:"Page status (draft, published)"`
+
+	ContentMetaFlags `embed:""`
 }
 
 // Run executes the update subcommand of the pages command
@@ -360,48 +343,13 @@ func (c *PagesUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 		return fmt.Errorf("failed to get page: %w", err)
 	}
 
-	// Determine content and format
-	var htmlContent string
-	var format input.ContentFormat
-
-	// Auto-detect format when file is specified
-	if c.File != "" {
-		fileContent, detectedFormat, err := input.ReadContentWithFormat(c.File, "")
-		if err != nil {
-			return fmt.Errorf("failed to read file: %w", err)
-		}
-		format = detectedFormat
-
-		// Process according to format
-		switch format {
-		case input.FormatMarkdown:
-			// Convert Markdown to HTML
-			htmlContent, err = markdown.ConvertToHTML(fileContent)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		case input.FormatHTML:
-			// Use HTML as-is
-			htmlContent = fileContent
-		case input.FormatLexical:
-			// Use Lexical JSON as-is (set to c.Lexical)
-			c.Lexical = fileContent
-		default:
-			// Treat as HTML for unknown formats
-			htmlContent = fileContent
-		}
-	} else {
-		// Process inline content
-		htmlContent = c.HTML
-
-		// Convert Markdown to HTML if Markdown flag is specified
-		if c.Markdown != "" {
-			htmlContent, err = markdown.ConvertToHTML(c.Markdown)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		}
+	// Read content (Markdown is converted to Lexical locally)
+	content, err := loadContent(ctx, client, c.File, c.HTML, c.Markdown, c.Lexical, &c.ContentMetaFlags)
+	if err != nil {
+		return err
 	}
+	htmlContent, frontMatter := content.HTML, content.FrontMatter
+	c.Lexical = content.Lexical
 
 	// Apply updates
 	updatePage := &ghostapi.Page{
@@ -413,18 +361,19 @@ func (c *PagesUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 		UpdatedAt: existingPage.UpdatedAt, // Use original updated_at from server (for optimistic locking)
 	}
 
-	if c.Title != "" {
-		updatePage.Title = c.Title
+	// Merge front matter with flags (flags take precedence)
+	meta, err := resolveContentMeta(frontMatter, c.Title, c.Status, c.ContentMetaFlags)
+	if err != nil {
+		return err
 	}
+	meta.apply(pageFields(updatePage))
+
 	if htmlContent != "" {
 		updatePage.HTML = htmlContent
 	}
 	if c.Lexical != "" {
 		updatePage.Lexical = c.Lexical
 	}
-	if c.Status != "" {
-		updatePage.Status = c.Status
-	}
 
 	// Automatically apply source=html when HTML content is updated
 	var updatedPage *ghostapi.Page
@@ -677,8 +626,9 @@ func (c *PagesUnpublishCmd) Run(ctx context.Context, root *RootFlags) error {
 
 // PagesCatCmd is the command to show page content body
 type PagesCatCmd struct {
-	IDOrSlug string `arg:"" help:"Page ID or slug"`
-	Format   string `help:"Output format (text, html, lexical)" default:"text"`
+	IDOrSlug    string `arg:"" help:"Page ID or slug"`
+	Format      string `help:"Output format (text, html, lexical, markdown)" default:"text"`
+	FrontMatter bool   `help:"Prepend YAML front matter to markdown output (for create/update --file)"`
 }
 
 // Run executes the cat subcommand of the pages command
@@ -708,8 +658,17 @@ func (c *PagesCatCmd) Run(ctx context.Context, root *RootFlags) error {
 		content = html2text.HTML2Text(page.HTML)
 	case "lexical":
 		content = page.Lexical
+	case "markdown":
+		var fm *input.FrontMatter
+		if c.FrontMatter {
+			fm = pageFields(page).frontMatter()
+		}
+		content, err = renderMarkdown(page.HTML, page.Lexical, fm)
+		if err != nil {
+			return err
+		}
 	default:
-		return fmt.Errorf("unsupported format: %s (please specify one of: html, text, lexical)", c.Format)
+		return fmt.Errorf("unsupported format: %s (please specify one of: html, text, lexical, markdown)", c.Format)
 	}
 
 	// Output content
@@ -1164,3 +1123,64 @@ func (c *PagesSearchCmd) Run(ctx context.Context, root *RootFlags) error {
 
 	return formatter.PrintTable(headers, rows)
 }
+
+// ========================================
+// Content diff
+// ========================================
+
+// PagesDiffCmd is the command to compare a page with a local file
+type PagesDiffCmd struct {
+	ID   string `arg:"" help:"Page ID"`
+	File string `arg:"" help:"Content file (auto-detect format, YAML front matter supported)" type:"existingfile"`
+
+	ContentDiffFlags `embed:""`
+}
+
+// Run executes the diff subcommand of the pages command
+func (c *PagesDiffCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get page
+	page, err := client.GetPage(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+
+	// Read the file as update --file would (local images are compared as written)
+	content, err := loadContent(ctx, client, c.File, "", "", "", &ContentMetaFlags{NoUploadImages: true})
+	if err != nil {
+		return err
+	}
+	meta, err := resolveContentMeta(content.FrontMatter, "", "", ContentMetaFlags{})
+	if err != nil {
+		return err
+	}
+	updated := *page
+	meta.apply(pageFields(&updated))
+
+	diff, err := diffContent("page "+page.ID, c.File, pageFields(page), pageFields(&updated), page.HTML, page.Lexical, content, c.ContentDiffFlags)
+	if err != nil {
+		return err
+	}
+	return printContentDiff(os.Stdout, root, diff, c.ExitCode)
+}
+
+// ========================================
+// Editor
+// ========================================
+
+// PagesEditCmd is the command to edit a page in a local editor
+type PagesEditCmd struct {
+	ID string `arg:"" help:"Page ID"`
+
+	ContentEditFlags `embed:""`
+}
+
+// Run executes the edit subcommand of the pages command
+func (c *PagesEditCmd) Run(ctx context.Context, root *RootFlags) error {
+	return editContent(ctx, root, "page", c.ID, c.ContentEditFlags)
+}
@@ -19,7 +19,7 @@ import (
 	"github.com/mtane0412/ghocli/internal/fields"
 	"github.com/mtane0412/ghocli/internal/ghostapi"
 	"github.com/mtane0412/ghocli/internal/input"
-	"github.com/mtane0412/ghocli/internal/markdown"
+	"github.com/mtane0412/ghocli/internal/lexical"
 	"github.com/mtane0412/ghocli/internal/outfmt"
 )
 
@@ -53,6 +53,18 @@ type PostsCmd struct {
 
 	// Phase 8.3: Copy
 	Copy PostsCopyCmd `cmd:"" help:"Copy a post"`
+
+	// Revision history
+	Revisions PostsRevisionsCmd `cmd:"" help:"Post revision history"`
+
+	// Newsletter email
+	Email PostsEmailCmd `cmd:"" help:"Newsletter email preview and test send"`
+
+	// Content diff
+	Diff PostsDiffCmd `cmd:"" help:"Show what update --file would change"`
+
+	// Editor
+	Edit PostsEditCmd `cmd:"" help:"Edit a post as Markdown in $VISUAL/$EDITOR"`
 }
 
 // PostsListCmd is the command to retrieve post list
@@ -212,7 +224,7 @@ func (c *PostsInfoCmd) Run(ctx context.Context, root *RootFlags) error {
 	}
 
 	// Add featured (if true)
-	if post.Featured {
+	if post.Featured != nil && *post.Featured {
 		rows = append(rows, []string{"featured", "true"})
 	}
 
@@ -238,12 +250,14 @@ func (c *PostsInfoCmd) Run(ctx context.Context, root *RootFlags) error {
 
 // PostsCreateCmd is the command to create post
 type PostsCreateCmd struct {
-	Title    string `help:"Post title" short:"t" required:""`
+	Title    string `help:"Post title (required unless set in front matter)" short:"t"`
 	HTML     string `help:"Post content (HTML)" short:"c"`
 	Markdown string `help:"Post content (Markdown)" short:"m"`
 	Lexical  string `help:"Post content (Lexical JSON)" short:"x"`
-	File     string `help:"Read content from file (auto-detect format)" type:"existingfile"`
-	Status   string `help:"Post status (draft, published)" default:"draft"`
+	File     string `help:"Read content from file (auto-detect format, YAML front matter supported)" type:"existingfile"`
+	Status   string `help:"Post status (draft, published; default: draft)"`
+
+	ContentMetaFlags `embed:""`
 }
 
 // Run executes the create subcommand of the posts command
@@ -254,56 +268,30 @@ func (c *PostsCreateCmd) Run(ctx context.Context, root *RootFlags) error {
 		return err
 	}
 
-	// Determine content and format
-	var htmlContent string
-	var format input.ContentFormat
-
-	// Auto-detect format when file is specified
-	if c.File != "" {
-		fileContent, detectedFormat, err := input.ReadContentWithFormat(c.File, "")
-		if err != nil {
-			return fmt.Errorf("failed to read file: %w", err)
-		}
-		format = detectedFormat
-
-		// Process according to format
-		switch format {
-		case input.FormatMarkdown:
-			// Convert Markdown to HTML
-			htmlContent, err = markdown.ConvertToHTML(fileContent)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		case input.FormatHTML:
-			// Use HTML as-is
-			htmlContent = fileContent
-		case input.FormatLexical:
-			// Use Lexical JSON as-is (set to c.Lexical)
-			c.Lexical = fileContent
-		default:
-			// Treat as HTML for unknown formats
-			htmlContent = fileContent
-		}
-	} else {
-		// Process inline content
-		htmlContent = c.HTML
+	// Read content (Markdown is converted to Lexical locally)
+	content, err := loadContent(ctx, client, c.File, c.HTML, c.Markdown, c.Lexical, &c.ContentMetaFlags)
+	if err != nil {
+		return err
+	}
+	htmlContent, frontMatter := content.HTML, content.FrontMatter
+	c.Lexical = content.Lexical
 
-		// Convert Markdown to HTML if Markdown flag is specified
-		if c.Markdown != "" {
-			htmlContent, err = markdown.ConvertToHTML(c.Markdown)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		}
+	// Merge front matter with flags (flags take precedence)
+	meta, err := resolveContentMeta(frontMatter, c.Title, c.Status, c.ContentMetaFlags)
+	if err != nil {
+		return err
+	}
+	if meta.Title == "" {
+		return fmt.Errorf("title is required (--title or front matter)")
 	}
 
 	// Create new post
 	newPost := &ghostapi.Post{
-		Title:   c.Title,
 		HTML:    htmlContent,
 		Lexical: c.Lexical,
-		Status:  c.Status,
+		Status:  "draft",
 	}
+	meta.apply(postFields(newPost))
 
 	// Automatically apply source=html when HTML content is specified
 	var createdPost *ghostapi.Post
@@ -345,8 +333,10 @@ type PostsUpdateCmd struct {
 	HTML     string `help:"Post content (HTML)" short:"c"`
 	Markdown string `help:"Post content (Markdown)" short:"m"`
 	Lexical  string `help:"Post content (Lexical JSON)" short:"x"`
-	File     string `help:"Read content from file (auto-detect format)" type:"existingfile"`
+	File     string `help:"Read content from file (auto-detect format, YAML front matter supported)" type:"existingfile"`
 	Status   string `help:"Post status (draft, published)"`
+
+	ContentMetaFlags `embed:""`
 }
 
 // Run executes the update subcommand of the posts command
@@ -363,48 +353,13 @@ func (c *PostsUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 		return fmt.Errorf("failed to get post: %w", err)
 	}
 
-	// Determine content and format
-	var htmlContent string
-	var format input.ContentFormat
-
-	// Auto-detect format when file is specified
-	if c.File != "" {
-		fileContent, detectedFormat, err := input.ReadContentWithFormat(c.File, "")
-		if err != nil {
-			return fmt.Errorf("failed to read file: %w", err)
-		}
-		format = detectedFormat
-
-		// Process according to format
-		switch format {
-		case input.FormatMarkdown:
-			// Convert Markdown to HTML
-			htmlContent, err = markdown.ConvertToHTML(fileContent)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		case input.FormatHTML:
-			// Use HTML as-is
-			htmlContent = fileContent
-		case input.FormatLexical:
-			// Use Lexical JSON as-is (set to c.Lexical)
-			c.Lexical = fileContent
-		default:
-			// Treat as HTML for unknown formats
-			htmlContent = fileContent
-		}
-	} else {
-		// Process inline content
-		htmlContent = c.HTML
-
-		// Convert Markdown to HTML if Markdown flag is specified
-		if c.Markdown != "" {
-			htmlContent, err = markdown.ConvertToHTML(c.Markdown)
-			if err != nil {
-				return fmt.Errorf("failed to convert markdown to HTML: %w", err)
-			}
-		}
+	// Read content (Markdown is converted to Lexical locally)
+	content, err := loadContent(ctx, client, c.File, c.HTML, c.Markdown, c.Lexical, &c.ContentMetaFlags)
+	if err != nil {
+		return err
 	}
+	htmlContent, frontMatter := content.HTML, content.FrontMatter
+	c.Lexical = content.Lexical
 
 	// Apply updates
 	updatePost := &ghostapi.Post{
@@ -416,18 +371,19 @@ func (c *PostsUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 		UpdatedAt: existingPost.UpdatedAt, // Use original updated_at from server (for optimistic locking)
 	}
 
-	if c.Title != "" {
-		updatePost.Title = c.Title
+	// Merge front matter with flags (flags take precedence)
+	meta, err := resolveContentMeta(frontMatter, c.Title, c.Status, c.ContentMetaFlags)
+	if err != nil {
+		return err
 	}
+	meta.apply(postFields(updatePost))
+
 	if htmlContent != "" {
 		updatePost.HTML = htmlContent
 	}
 	if c.Lexical != "" {
 		updatePost.Lexical = c.Lexical
 	}
-	if c.Status != "" {
-		updatePost.Status = c.Status
-	}
 
 	// Automatically apply source=html when HTML content is updated
 	var updatedPost *ghostapi.Post
@@ -504,6 +460,8 @@ func (c *PostsDeleteCmd) Run(ctx context.Context, root *RootFlags) error {
 // PostsPublishCmd is the command to publish draft post
 type PostsPublishCmd struct {
 	ID string `arg:"" help:"Post ID"`
+
+	PostEmailFlags `embed:""`
 }
 
 // Run executes the publish subcommand of the posts command
@@ -525,6 +483,12 @@ func (c *PostsPublishCmd) Run(ctx context.Context, root *RootFlags) error {
 		return fmt.Errorf("post is already published")
 	}
 
+	// Resolve newsletter options and confirm the email send
+	opts, err := c.PostEmailFlags.prepare(ctx, root, client, existingPost.Title, nil)
+	if err != nil {
+		return err
+	}
+
 	// Change status to published
 	updatePost := &ghostapi.Post{
 		Title:     existingPost.Title,
@@ -532,11 +496,12 @@ func (c *PostsPublishCmd) Run(ctx context.Context, root *RootFlags) error {
 		HTML:      existingPost.HTML,
 		Lexical:   existingPost.Lexical,
 		Status:    "published",
+		EmailOnly: c.EmailOnly,
 		UpdatedAt: existingPost.UpdatedAt, // Use original updated_at from server (for optimistic locking)
 	}
 
 	// Update post
-	publishedPost, err := client.UpdatePost(c.ID, updatePost)
+	publishedPost, err := client.UpdatePostWithOptions(c.ID, updatePost, opts)
 	if err != nil {
 		return fmt.Errorf("failed to publish post: %w", err)
 	}
@@ -838,6 +803,8 @@ func (c *PostsUnpublishCmd) Run(ctx context.Context, root *RootFlags) error {
 type PostsScheduleCmd struct {
 	ID string `arg:"" help:"Post ID"`
 	At string `help:"Schedule time (YYYY-MM-DD HH:MM)" required:""`
+
+	PostEmailFlags `embed:""`
 }
 
 // Run executes the schedule subcommand of the posts command
@@ -860,6 +827,12 @@ func (c *PostsScheduleCmd) Run(ctx context.Context, root *RootFlags) error {
 		return fmt.Errorf("failed to parse datetime: %w", err)
 	}
 
+	// Resolve newsletter options and confirm the email send
+	opts, err := c.PostEmailFlags.prepare(ctx, root, client, existingPost.Title, &publishedAt)
+	if err != nil {
+		return err
+	}
+
 	// Change status to scheduled and set publish date
 	updatePost := &ghostapi.Post{
 		Title:       existingPost.Title,
@@ -868,11 +841,12 @@ func (c *PostsScheduleCmd) Run(ctx context.Context, root *RootFlags) error {
 		Lexical:     existingPost.Lexical,
 		Status:      "scheduled",
 		PublishedAt: &publishedAt,
+		EmailOnly:   c.EmailOnly,
 		UpdatedAt:   existingPost.UpdatedAt, // Use original updated_at from server (for optimistic locking)
 	}
 
 	// Update post
-	scheduledPost, err := client.UpdatePost(c.ID, updatePost)
+	scheduledPost, err := client.UpdatePostWithOptions(c.ID, updatePost, opts)
 	if err != nil {
 		return fmt.Errorf("failed to schedule post: %w", err)
 	}
@@ -1071,9 +1045,10 @@ func fileExists(path string) bool {
 	return err == nil
 }
 
-// runCommand executes a command
+// runCommand executes a command attached to the terminal (e.g. an editor)
 func runCommand(name string, args ...string) error {
 	cmd := exec.Command(name, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
 	return cmd.Run()
 }
 
@@ -1107,8 +1082,9 @@ func hasSubstringIgnoreCase(s, substr string) bool {
 
 // PostsCatCmd is the command to show post content body
 type PostsCatCmd struct {
-	IDOrSlug string `arg:"" help:"Post ID or slug"`
-	Format   string `help:"Output format (text, html, lexical)" default:"text"`
+	IDOrSlug    string `arg:"" help:"Post ID or slug"`
+	Format      string `help:"Output format (text, html, lexical, markdown)" default:"text"`
+	FrontMatter bool   `help:"Prepend YAML front matter to markdown output (for create/update --file)"`
 }
 
 // Run executes the cat subcommand of the posts command
@@ -1138,8 +1114,17 @@ func (c *PostsCatCmd) Run(ctx context.Context, root *RootFlags) error {
 		content = html2text.HTML2Text(post.HTML)
 	case "lexical":
 		content = post.Lexical
+	case "markdown":
+		var fm *input.FrontMatter
+		if c.FrontMatter {
+			fm = postFields(post).frontMatter()
+		}
+		content, err = renderMarkdown(post.HTML, post.Lexical, fm)
+		if err != nil {
+			return err
+		}
 	default:
-		return fmt.Errorf("unsupported format: %s (please specify one of: html, text, lexical)", c.Format)
+		return fmt.Errorf("unsupported format: %s (please specify one of: html, text, lexical, markdown)", c.Format)
 	}
 
 	// Output content
@@ -1207,3 +1192,479 @@ func (c *PostsCopyCmd) Run(ctx context.Context, root *RootFlags) error {
 
 	return nil
 }
+
+// ========================================
+// Revision history
+// ========================================
+
+// PostsRevisionsCmd is the post revision history command
+type PostsRevisionsCmd struct {
+	List    PostsRevisionsListCmd    `cmd:"" default:"withargs" help:"List revisions of a post"`
+	Show    PostsRevisionsShowCmd    `cmd:"" help:"Show the content of a revision"`
+	Restore PostsRevisionsRestoreCmd `cmd:"" help:"Restore a post to a revision"`
+}
+
+// PostsRevisionsListCmd is the command to list revisions of a post
+type PostsRevisionsListCmd struct {
+	ID string `arg:"" help:"Post ID"`
+}
+
+// Run executes the revisions list subcommand of the posts command
+func (c *PostsRevisionsListCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get revisions
+	revisions, err := client.ListPostRevisions(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(revisions)
+	}
+
+	// Output in table format
+	headers := []string{"ID", "Created", "Author", "Reason", "Status", "Title"}
+	rows := make([][]string, len(revisions))
+	for i, rev := range revisions {
+		author := rev.AuthorID
+		if rev.Author != nil && rev.Author.Name != "" {
+			author = rev.Author.Name
+		}
+		rows[i] = []string{
+			rev.ID,
+			rev.CreatedAt.Format("2006-01-02 15:04:05"),
+			author,
+			rev.Reason,
+			rev.PostStatus,
+			rev.Title,
+		}
+	}
+
+	return formatter.PrintTable(headers, rows)
+}
+
+// PostsRevisionsShowCmd is the command to show the content of a revision
+type PostsRevisionsShowCmd struct {
+	ID       string `arg:"" help:"Post ID"`
+	Revision string `arg:"" help:"Revision ID"`
+	Format   string `help:"Output format (text, html, lexical)" default:"text"`
+}
+
+// Run executes the revisions show subcommand of the posts command
+func (c *PostsRevisionsShowCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get revision
+	rev, err := client.GetPostRevision(c.ID, c.Revision)
+	if err != nil {
+		return fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output according to format (revisions only store Lexical, so render locally)
+	var content string
+	switch c.Format {
+	case "lexical":
+		content = rev.Lexical
+	case "html", "text":
+		html, err := lexical.RenderHTMLString(rev.Lexical)
+		if err != nil {
+			return fmt.Errorf("failed to render revision: %w", err)
+		}
+		content = html
+		if c.Format == "text" {
+			content = html2text.HTML2Text(html)
+		}
+	default:
+		return fmt.Errorf("unsupported format: %s (please specify one of: html, text, lexical)", c.Format)
+	}
+
+	// Output content
+	formatter.PrintMessage(content)
+
+	return nil
+}
+
+// PostsRevisionsRestoreCmd is the command to restore a post to a revision
+type PostsRevisionsRestoreCmd struct {
+	ID       string `arg:"" help:"Post ID"`
+	Revision string `arg:"" help:"Revision ID"`
+}
+
+// Run executes the revisions restore subcommand of the posts command
+func (c *PostsRevisionsRestoreCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get revision
+	rev, err := client.GetPostRevision(c.ID, c.Revision)
+	if err != nil {
+		return fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	// Get existing post (for current updated_at)
+	existingPost, err := client.GetPost(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("restore post '%s' (ID: %s) to revision from %s", existingPost.Title, c.ID, rev.CreatedAt.Format("2006-01-02 15:04:05"))
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Write the revision's Lexical back
+	updatePost := &ghostapi.Post{
+		Title:     existingPost.Title,
+		Slug:      existingPost.Slug,
+		Lexical:   rev.Lexical,
+		Status:    existingPost.Status,
+		UpdatedAt: existingPost.UpdatedAt, // Use original updated_at from server (for optimistic locking)
+	}
+	if rev.Title != "" {
+		updatePost.Title = rev.Title
+	}
+
+	// Update post
+	restoredPost, err := client.UpdatePost(c.ID, updatePost)
+	if err != nil {
+		return fmt.Errorf("failed to restore revision: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	if !root.JSON {
+		formatter.PrintMessage(fmt.Sprintf("restored post: %s (ID: %s) to revision %s", restoredPost.Title, restoredPost.ID, rev.ID))
+	}
+
+	// Also output post information if JSON format
+	if root.JSON {
+		return formatter.Print(restoredPost)
+	}
+
+	return nil
+}
+
+// ========================================
+// Newsletter email
+// ========================================
+
+// PostsEmailCmd is the post newsletter email command
+type PostsEmailCmd struct {
+	Preview PostsEmailPreviewCmd `cmd:"" help:"Show the email version of a post"`
+	Test    PostsEmailTestCmd    `cmd:"" help:"Send a test email of a post"`
+	Stats   PostsEmailStatsCmd   `cmd:"" help:"Show email delivery analytics of a sent post"`
+}
+
+// PostEmailFlags are flags for sending a post as a newsletter email on publish/schedule
+type PostEmailFlags struct {
+	Newsletter   string `help:"Send the post as an email via this newsletter (slug)"`
+	EmailSegment string `help:"Members to email (all, status:free, status:-free, or NQL filter)" default:"all"`
+	EmailOnly    bool   `help:"Send as an email only, without publishing on the site"`
+}
+
+// prepare validates the newsletter, counts recipients, and confirms the send
+//
+// Returns CreateOptions carrying the newsletter query parameters.
+// Without --newsletter no email is sent and no confirmation is requested.
+// scheduledAt is nil when the post is published now.
+func (f *PostEmailFlags) prepare(ctx context.Context, root *RootFlags, client *ghostapi.Client, title string, scheduledAt *time.Time) (ghostapi.CreateOptions, error) {
+	if f.Newsletter == "" {
+		if f.EmailOnly {
+			return ghostapi.CreateOptions{}, fmt.Errorf("--email-only requires --newsletter")
+		}
+		if f.EmailSegment != "" && f.EmailSegment != "all" {
+			return ghostapi.CreateOptions{}, fmt.Errorf("--email-segment requires --newsletter")
+		}
+		return ghostapi.CreateOptions{}, nil
+	}
+
+	segment := f.EmailSegment
+	if segment == "" {
+		segment = "all"
+	}
+
+	// Resolve newsletter slug
+	newsletter, err := client.GetNewsletter("slug:" + f.Newsletter)
+	if err != nil {
+		return ghostapi.CreateOptions{}, fmt.Errorf("failed to get newsletter: %w", err)
+	}
+	if newsletter.Status != "" && newsletter.Status != "active" {
+		return ghostapi.CreateOptions{}, fmt.Errorf("newsletter '%s' is %s", newsletter.Slug, newsletter.Status)
+	}
+
+	// Count recipients subscribed to the newsletter within the segment
+	filter := "newsletters.slug:" + newsletter.Slug
+	if segment != "all" {
+		filter += "+(" + segment + ")"
+	}
+	recipients, err := client.CountMembers(filter)
+	if err != nil {
+		return ghostapi.CreateOptions{}, fmt.Errorf("failed to count recipients: %w", err)
+	}
+
+	// Confirm sending
+	action := emailAction(title, scheduledAt, f.EmailOnly, recipients, newsletter.Name)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return ghostapi.CreateOptions{}, err
+	}
+
+	return ghostapi.CreateOptions{
+		Newsletter:   newsletter.Slug,
+		EmailSegment: segment,
+	}, nil
+}
+
+// emailAction describes publishing or scheduling a post with an email for the confirmation prompt
+func emailAction(title string, scheduledAt *time.Time, emailOnly bool, recipients int, newsletter string) string {
+	audience := fmt.Sprintf("%d members via newsletter '%s'", recipients, newsletter)
+	switch {
+	case scheduledAt != nil && emailOnly:
+		return fmt.Sprintf("schedule post '%s' for %s as an email only to %s", title, scheduledAt.Format("2006-01-02 15:04"), audience)
+	case scheduledAt != nil:
+		return fmt.Sprintf("schedule post '%s' for %s and email it to %s", title, scheduledAt.Format("2006-01-02 15:04"), audience)
+	case emailOnly:
+		return fmt.Sprintf("send post '%s' as an email only to %s", title, audience)
+	}
+	return fmt.Sprintf("publish post '%s' and email it to %s", title, audience)
+}
+
+// PostsEmailPreviewCmd is the command to render the email version of a post
+type PostsEmailPreviewCmd struct {
+	ID         string `arg:"" help:"Post ID"`
+	Newsletter string `help:"Newsletter slug (defaults to the default newsletter)"`
+	Segment    string `help:"Member segment filter (e.g., status:free, status:-free)"`
+	Output     string `help:"Save the email HTML to this file" short:"o" type:"path"`
+}
+
+// Run executes the email preview subcommand of the posts command
+func (c *PostsEmailPreviewCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get email preview
+	preview, err := client.GetPostEmailPreview(c.ID, ghostapi.EmailPreviewOptions{
+		Newsletter:    c.Newsletter,
+		MemberSegment: c.Segment,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get email preview: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Save HTML to file if requested
+	if c.Output != "" {
+		if err := os.WriteFile(c.Output, []byte(preview.HTML), 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+	}
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(preview)
+	}
+
+	formatter.PrintMessage(fmt.Sprintf("subject: %s", preview.Subject))
+	if c.Output != "" {
+		formatter.PrintMessage(fmt.Sprintf("saved email HTML: %s", c.Output))
+	} else {
+		formatter.PrintMessage(preview.HTML)
+	}
+
+	return nil
+}
+
+// PostsEmailTestCmd is the command to send a test email of a post
+type PostsEmailTestCmd struct {
+	ID         string   `arg:"" help:"Post ID"`
+	To         []string `help:"Recipient email addresses (comma-separated)" required:""`
+	Newsletter string   `help:"Newsletter slug (defaults to the default newsletter)"`
+	Segment    string   `help:"Member segment filter (e.g., status:free, status:-free)"`
+}
+
+// Run executes the email test subcommand of the posts command
+func (c *PostsEmailTestCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Send test email
+	err = client.SendTestEmail(c.ID, c.To, ghostapi.EmailPreviewOptions{
+		Newsletter:    c.Newsletter,
+		MemberSegment: c.Segment,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send test email: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("sent test email of post %s to: %s", c.ID, strings.Join(c.To, ", ")))
+
+	return nil
+}
+
+// PostsEmailStatsCmd is the command to show email delivery analytics of a post
+type PostsEmailStatsCmd struct {
+	ID string `arg:"" help:"Post ID"`
+}
+
+// Run executes the email stats subcommand of the posts command
+func (c *PostsEmailStatsCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get email analytics
+	stats, err := client.GetPostEmailStats(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get email stats: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(stats)
+	}
+
+	// Output in key/value format (no headers)
+	email := stats.Email
+	rows := [][]string{
+		{"email", email.ID},
+		{"status", email.Status},
+		{"subject", email.Subject},
+		{"recipients", fmt.Sprintf("%d", email.EmailCount)},
+		{"delivered", formatEmailCount(email.DeliveredCount, email.EmailCount)},
+		{"opened", formatEmailCount(email.OpenedCount, email.DeliveredCount)},
+		{"clicked", formatEmailCount(stats.Clicked, email.DeliveredCount)},
+		{"failed", formatEmailCount(email.FailedCount, email.EmailCount)},
+	}
+	if email.Error != "" {
+		rows = append(rows, []string{"error", email.Error})
+	}
+	if err := formatter.PrintKeyValue(rows); err != nil {
+		return err
+	}
+	if err := formatter.Flush(); err != nil {
+		return err
+	}
+
+	// Output link clicks
+	if len(stats.Links) == 0 {
+		return nil
+	}
+	formatter.PrintMessage("")
+	headers := []string{"Clicks", "URL"}
+	linkRows := make([][]string, len(stats.Links))
+	for i, link := range stats.Links {
+		linkRows[i] = []string{
+			fmt.Sprintf("%d", link.Count.Clicks),
+			link.Link.To,
+		}
+	}
+
+	return formatter.PrintTable(headers, linkRows)
+}
+
+// formatEmailCount formats a count with its percentage of the total
+func formatEmailCount(count, total int) string {
+	if total == 0 {
+		return fmt.Sprintf("%d", count)
+	}
+	return fmt.Sprintf("%d (%.1f%%)", count, float64(count)*100/float64(total))
+}
+
+// ========================================
+// Content diff
+// ========================================
+
+// PostsDiffCmd is the command to compare a post with a local file
+type PostsDiffCmd struct {
+	ID   string `arg:"" help:"Post ID"`
+	File string `arg:"" help:"Content file (auto-detect format, YAML front matter supported)" type:"existingfile"`
+
+	ContentDiffFlags `embed:""`
+}
+
+// Run executes the diff subcommand of the posts command
+func (c *PostsDiffCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get post
+	post, err := client.GetPost(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	// Read the file as update --file would (local images are compared as written)
+	content, err := loadContent(ctx, client, c.File, "", "", "", &ContentMetaFlags{NoUploadImages: true})
+	if err != nil {
+		return err
+	}
+	meta, err := resolveContentMeta(content.FrontMatter, "", "", ContentMetaFlags{})
+	if err != nil {
+		return err
+	}
+	updated := *post
+	meta.apply(postFields(&updated))
+
+	diff, err := diffContent("post "+post.ID, c.File, postFields(post), postFields(&updated), post.HTML, post.Lexical, content, c.ContentDiffFlags)
+	if err != nil {
+		return err
+	}
+	return printContentDiff(os.Stdout, root, diff, c.ExitCode)
+}
+
+// ========================================
+// Editor
+// ========================================
+
+// PostsEditCmd is the command to edit a post in a local editor
+type PostsEditCmd struct {
+	ID string `arg:"" help:"Post ID"`
+
+	ContentEditFlags `embed:""`
+}
+
+// Run executes the edit subcommand of the posts command
+func (c *PostsEditCmd) Run(ctx context.Context, root *RootFlags) error {
+	return editContent(ctx, root, "post", c.ID, c.ContentEditFlags)
+}
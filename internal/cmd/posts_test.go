@@ -8,8 +8,11 @@
 package cmd
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/alecthomas/kong"
 	"github.com/k3a/html2text"
 )
 
@@ -172,3 +175,110 @@ func TestPostsInfoCmd_FieldListDisplay(t *testing.T) {
 	// Not implemented as unit test because it includes actual API calls
 	t.Skip("Implement in integration test")
 }
+
+// TestPostsRevisionsCmd_DefaultList verifies that "posts revisions ID" runs the list subcommand
+func TestPostsRevisionsCmd_DefaultList(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	kctx, err := parser.Parse([]string{"posts", "revisions", "64fac5417c4c6b0001234567"})
+	if err != nil {
+		t.Fatalf("failed to parse command line: %v", err)
+	}
+	if kctx.Command() != "posts revisions list <id>" {
+		t.Errorf("command = %q; want %q", kctx.Command(), "posts revisions list <id>")
+	}
+	if cli.Posts.Revisions.List.ID != "64fac5417c4c6b0001234567" {
+		t.Errorf("ID = %q; want %q", cli.Posts.Revisions.List.ID, "64fac5417c4c6b0001234567")
+	}
+
+	// show subcommand is still reachable
+	if _, err := parser.Parse([]string{"posts", "revisions", "show", "post-id", "rev-id", "--format", "html"}); err != nil {
+		t.Fatalf("failed to parse show command: %v", err)
+	}
+	if cli.Posts.Revisions.Show.Revision != "rev-id" {
+		t.Errorf("Revision = %q; want %q", cli.Posts.Revisions.Show.Revision, "rev-id")
+	}
+}
+
+// TestPostsEmailTestCmd_ParsesRecipients verifies that --to accepts comma-separated addresses
+func TestPostsEmailTestCmd_ParsesRecipients(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"posts", "email", "test", "post-id", "--to", "a@b.example,c@d.example"}); err != nil {
+		t.Fatalf("failed to parse command line: %v", err)
+	}
+	if len(cli.Posts.Email.Test.To) != 2 || cli.Posts.Email.Test.To[1] != "c@d.example" {
+		t.Errorf("To = %v; want [a@b.example c@d.example]", cli.Posts.Email.Test.To)
+	}
+}
+
+// TestPostsPublishCmd_NewsletterFlags verifies that publish accepts newsletter flags
+func TestPostsPublishCmd_NewsletterFlags(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	args := []string{"posts", "publish", "post-id", "--newsletter", "weekly", "--email-segment", "status:-free", "--email-only"}
+	if _, err := parser.Parse(args); err != nil {
+		t.Fatalf("failed to parse command line: %v", err)
+	}
+	flags := cli.Posts.Publish.PostEmailFlags
+	if flags.Newsletter != "weekly" || flags.EmailSegment != "status:-free" || !flags.EmailOnly {
+		t.Errorf("flags = %+v; want newsletter=weekly, segment=status:-free, email-only", flags)
+	}
+
+	// Schedule defaults to the whole audience
+	if _, err := parser.Parse([]string{"posts", "schedule", "post-id", "--at", "2026-12-31 09:00", "--newsletter", "weekly"}); err != nil {
+		t.Fatalf("failed to parse command line: %v", err)
+	}
+	if cli.Posts.Schedule.EmailSegment != "all" {
+		t.Errorf("EmailSegment = %q; want %q", cli.Posts.Schedule.EmailSegment, "all")
+	}
+}
+
+// TestEmailAction verifies the confirmation text for each publish/schedule action
+func TestEmailAction(t *testing.T) {
+	at := time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		scheduledAt *time.Time
+		emailOnly   bool
+		want        string
+	}{
+		{nil, false, "publish post 'Hello' and email it to 42 members via newsletter 'Weekly'"},
+		{nil, true, "send post 'Hello' as an email only to 42 members via newsletter 'Weekly'"},
+		{&at, false, "schedule post 'Hello' for 2026-12-31 09:00 and email it to 42 members via newsletter 'Weekly'"},
+		{&at, true, "schedule post 'Hello' for 2026-12-31 09:00 as an email only to 42 members via newsletter 'Weekly'"},
+	}
+	for _, tt := range tests {
+		if got := emailAction("Hello", tt.scheduledAt, tt.emailOnly, 42, "Weekly"); got != tt.want {
+			t.Errorf("emailAction(%v, %v) = %q; want %q", tt.scheduledAt, tt.emailOnly, got, tt.want)
+		}
+	}
+}
+
+// TestPostEmailFlags_RequireNewsletter verifies email flags are rejected without a newsletter
+func TestPostEmailFlags_RequireNewsletter(t *testing.T) {
+	flags := &PostEmailFlags{EmailOnly: true, EmailSegment: "all"}
+	if _, err := flags.prepare(context.Background(), &RootFlags{}, nil, "Post", nil); err == nil {
+		t.Error("expected error for --email-only without --newsletter")
+	}
+
+	flags = &PostEmailFlags{EmailSegment: "all"}
+	opts, err := flags.prepare(context.Background(), &RootFlags{}, nil, "Post", nil)
+	if err != nil {
+		t.Fatalf("prepare() error: %v", err)
+	}
+	if opts.Newsletter != "" {
+		t.Errorf("Newsletter = %q; want empty", opts.Newsletter)
+	}
+}
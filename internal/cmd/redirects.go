@@ -0,0 +1,267 @@
+/**
+ * redirects.go
+ * Redirects management commands
+ *
+ * Provides download, validation, and upload of Ghost's redirects file
+ * (redirects.yaml or redirects.json). Files are validated locally before
+ * upload; uploading replaces all redirects and requires confirmation.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mtane0412/ghocli/internal/outfmt"
+	"github.com/mtane0412/ghocli/internal/redirects"
+)
+
+// RedirectsCmd is the redirects management command
+type RedirectsCmd struct {
+	Download RedirectsDownloadCmd `cmd:"" help:"Download the redirects file"`
+	Upload   RedirectsUploadCmd   `cmd:"" help:"Upload a redirects file (replaces all redirects)"`
+	Validate RedirectsValidateCmd `cmd:"" help:"Validate a redirects file locally"`
+	Add      RedirectsAddCmd      `cmd:"" help:"Add a redirect rule"`
+}
+
+// RedirectsDownloadCmd is the command to download the redirects file
+type RedirectsDownloadCmd struct {
+	Output string `help:"Output file path (default: redirects.yaml or redirects.json; '-' for stdout)" short:"o"`
+}
+
+// Run executes the download subcommand of the redirects command
+func (c *RedirectsDownloadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Download redirects file
+	data, err := client.DownloadRedirects()
+	if err != nil {
+		return fmt.Errorf("failed to download redirects: %w", err)
+	}
+
+	// Write to stdout
+	if c.Output == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	// Write to file (named after the detected format)
+	output := c.Output
+	if output == "" {
+		output = "redirects." + string(redirects.DetectFormat(data))
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("downloaded redirects: %s", output))
+
+	return nil
+}
+
+// RedirectsUploadCmd is the command to upload a redirects file
+type RedirectsUploadCmd struct {
+	File string `arg:"" help:"Redirects file (.yaml or .json)" type:"existingfile"`
+}
+
+// Run executes the upload subcommand of the redirects command
+func (c *RedirectsUploadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Read and validate file before anything is sent
+	data, rules, format, err := readRedirectsFile(c.File)
+	if err != nil {
+		return err
+	}
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+	if err := checkRedirects(formatter, rules); err != nil {
+		return err
+	}
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("replace all redirects with %d rules from %s", len(rules), c.File)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Upload file
+	if err := client.UploadRedirects(bytes.NewReader(data), "redirects."+string(format)); err != nil {
+		return fmt.Errorf("failed to upload redirects: %w", err)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("uploaded %d redirects", len(rules)))
+
+	return nil
+}
+
+// RedirectsValidateCmd is the command to validate a redirects file
+type RedirectsValidateCmd struct {
+	File string `arg:"" help:"Redirects file (.yaml or .json)" type:"existingfile"`
+}
+
+// Run executes the validate subcommand of the redirects command
+func (c *RedirectsValidateCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Read file
+	_, rules, _, err := readRedirectsFile(c.File)
+	if err != nil {
+		return err
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	issues := redirects.Validate(rules)
+	if root.JSON {
+		if issues == nil {
+			issues = []redirects.Issue{}
+		}
+		if err := formatter.Print(issues); err != nil {
+			return err
+		}
+		if redirects.HasErrors(issues) {
+			return &ExitError{Code: 1, Err: fmt.Errorf("redirects file has errors")}
+		}
+		return nil
+	}
+
+	if err := checkRedirects(formatter, rules); err != nil {
+		return err
+	}
+	formatter.PrintMessage(fmt.Sprintf("%s: %d rules OK", c.File, len(rules)))
+
+	return nil
+}
+
+// RedirectsAddCmd is the command to add a redirect rule
+type RedirectsAddCmd struct {
+	From      string `arg:"" help:"Source path or regular expression (e.g., ^/old/$)"`
+	To        string `arg:"" help:"Target path or URL"`
+	Permanent bool   `help:"Use a permanent (301) redirect instead of 302"`
+}
+
+// Run executes the add subcommand of the redirects command
+func (c *RedirectsAddCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Download and parse current redirects
+	data, err := client.DownloadRedirects()
+	if err != nil {
+		return fmt.Errorf("failed to download redirects: %w", err)
+	}
+	format := redirects.DetectFormat(data)
+	rules, err := redirects.Parse(data, format)
+	if err != nil {
+		return err
+	}
+
+	// Merge the new rule and validate the result in the order it is written
+	// (YAML groups rules by status, so the new rule may not come last)
+	rule := redirects.Redirect{From: c.From, To: c.To, Permanent: c.Permanent}
+	rules = append(rules, rule)
+	if format == redirects.FormatYAML {
+		rules = redirects.Grouped(rules)
+	}
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+	if err := checkRedirects(formatter, rules); err != nil {
+		return err
+	}
+
+	// Confirm destructive operation
+	status := 302
+	if c.Permanent {
+		status = 301
+	}
+	placement := ""
+	if rules[len(rules)-1] != rule {
+		placement = fmt.Sprintf(" after the last %d rule, not at the end (the YAML file groups rules by status)", status)
+	}
+	action := fmt.Sprintf("add redirect %s -> %s (%d)%s", c.From, c.To, status, placement)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Upload merged file in its original format
+	merged, err := redirects.Marshal(rules, format)
+	if err != nil {
+		return err
+	}
+	if err := client.UploadRedirects(bytes.NewReader(merged), "redirects."+string(format)); err != nil {
+		return fmt.Errorf("failed to upload redirects: %w", err)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("added redirect %s -> %s%s (%d rules total)", c.From, c.To, placement, len(rules)))
+
+	return nil
+}
+
+// readRedirectsFile reads and parses a redirects file
+//
+// The format is taken from the extension, falling back to content detection.
+func readRedirectsFile(path string) ([]byte, []redirects.Redirect, redirects.Format, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	format := redirects.FormatFromFilename(filepath.Base(path))
+	if format == "" {
+		format = redirects.DetectFormat(data)
+	}
+
+	rules, err := redirects.Parse(data, format)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return data, rules, format, nil
+}
+
+// checkRedirects validates rules, prints any issues, and fails on errors
+func checkRedirects(formatter *outfmt.Formatter, rules []redirects.Redirect) error {
+	issues := redirects.Validate(rules)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	headers := []string{"Rule", "From", "Severity", "Message"}
+	rows := make([][]string, len(issues))
+	for i, issue := range issues {
+		rows[i] = []string{
+			fmt.Sprintf("%d", issue.Rule),
+			rules[issue.Rule-1].From,
+			string(issue.Severity),
+			issue.Message,
+		}
+	}
+	if err := formatter.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	if redirects.HasErrors(issues) {
+		return &ExitError{Code: 1, Err: fmt.Errorf("redirects file has errors")}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/**
+ * redirects_test.go
+ * Test code for redirects management commands
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestRedirectsCmd_StructExists verifies that redirects command structs exist
+func TestRedirectsCmd_StructExists(t *testing.T) {
+	// Verify that redirects commands are defined
+	_ = &RedirectsDownloadCmd{}
+	_ = &RedirectsUploadCmd{}
+	_ = &RedirectsValidateCmd{}
+	_ = &RedirectsAddCmd{}
+}
+
+// TestRedirectsValidateCmd_FailsOnLoop verifies validate exits with an error for loops
+func TestRedirectsValidateCmd_FailsOnLoop(t *testing.T) {
+	// Create redirects file with a loop
+	path := filepath.Join(t.TempDir(), "redirects.yaml")
+	content := "301:\n  ^/a/$: /b/\n  ^/b/$: /a/\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	cmd := &RedirectsValidateCmd{File: path}
+	err := cmd.Run(context.Background(), &RootFlags{JSON: true})
+	if ExitCode(err) != 1 {
+		t.Errorf("ExitCode = %d (err: %v); want 1", ExitCode(err), err)
+	}
+}
+
+// TestRedirectsValidateCmd_ValidFile verifies validate succeeds for a clean file
+func TestRedirectsValidateCmd_ValidFile(t *testing.T) {
+	// Create valid redirects file
+	path := filepath.Join(t.TempDir(), "redirects.json")
+	content := `[{"from": "^/old/$", "to": "/new/", "permanent": true}]`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	cmd := &RedirectsValidateCmd{File: path}
+	if err := cmd.Run(context.Background(), &RootFlags{JSON: true}); err != nil {
+		t.Errorf("Run() error: %v", err)
+	}
+}
@@ -41,14 +41,25 @@ type CLI struct {
 	Pages       PagesCmd       `cmd:"" aliases:"page" help:"Pages management"`
 	Tags        TagsCmd        `cmd:"" aliases:"tag,t" help:"Tags management"`
 	Images      ImagesCmd      `cmd:"" aliases:"image,img" help:"Images management"`
+	Media       MediaCmd       `cmd:"" help:"Video and audio uploads"`
+	Files       FilesCmd       `cmd:"" aliases:"file" help:"File (attachment) uploads"`
 	Members     MembersCmd     `cmd:"" aliases:"member,m" help:"Members management"`
 	Users       UsersCmd       `cmd:"" aliases:"user,u" help:"Users management"`
+	Invites     InvitesCmd     `cmd:"" aliases:"invite" help:"Staff invitations"`
+	Roles       RolesCmd       `cmd:"" aliases:"role" help:"Staff roles"`
 	Newsletters NewslettersCmd `cmd:"" aliases:"newsletter,nl" help:"Newsletters management"`
+	Emails      EmailsCmd      `cmd:"" aliases:"email" help:"Newsletter email delivery"`
 	Tiers       TiersCmd       `cmd:"" aliases:"tier" help:"Tiers management"`
 	Offers      OffersCmd      `cmd:"" aliases:"offer" help:"Offers management"`
 	Themes      ThemesCmd      `cmd:"" aliases:"theme" help:"Themes management"`
 	Webhooks    WebhooksCmd    `cmd:"" aliases:"webhook,wh" help:"Webhooks management"`
 	Settings    SettingsCmd    `cmd:"" aliases:"setting" help:"Settings management"`
+	Redirects   RedirectsCmd   `cmd:"" aliases:"redirect" help:"Redirects management"`
+	Routes      RoutesCmd      `cmd:"" aliases:"route" help:"Routes (routes.yaml) management"`
+	Snippets    SnippetsCmd    `cmd:"" aliases:"snippet" help:"Snippets management"`
+	Backup      BackupCmd      `cmd:"" help:"Content export and import"`
+	Snapshot    SnapshotCmd    `cmd:"" aliases:"snapshots" help:"Incremental local content snapshots"`
+	Sync        SyncCmd        `cmd:"" help:"Two-way sync with a local Markdown directory"`
 
 	Completion         CompletionCmd         `cmd:"" help:"Generate shell completion script"`
 	CompletionInternal CompletionInternalCmd `cmd:"" name:"__complete" hidden:"" help:""`
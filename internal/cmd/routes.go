@@ -0,0 +1,237 @@
+/**
+ * routes.go
+ * Routes management commands
+ *
+ * Provides download, validation, and upload of routes.yaml (dynamic routing).
+ * Files are validated locally, including templates in the active theme,
+ * before upload; uploading requires confirmation.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+	"github.com/mtane0412/ghocli/internal/routes"
+)
+
+// RoutesCmd is the routes management command
+type RoutesCmd struct {
+	Download RoutesDownloadCmd `cmd:"" help:"Download routes.yaml"`
+	Upload   RoutesUploadCmd   `cmd:"" help:"Upload routes.yaml"`
+	Validate RoutesValidateCmd `cmd:"" help:"Validate routes.yaml"`
+}
+
+// RoutesDownloadCmd is the command to download routes.yaml
+type RoutesDownloadCmd struct {
+	Output string `help:"Output file path ('-' for stdout)" short:"o" default:"routes.yaml"`
+}
+
+// Run executes the download subcommand of the routes command
+func (c *RoutesDownloadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Download routes.yaml
+	data, err := client.DownloadRoutes()
+	if err != nil {
+		return fmt.Errorf("failed to download routes: %w", err)
+	}
+
+	// Write to stdout
+	if c.Output == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	// Write to file
+	if err := os.WriteFile(c.Output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("downloaded routes: %s", c.Output))
+
+	return nil
+}
+
+// RoutesUploadCmd is the command to upload routes.yaml
+type RoutesUploadCmd struct {
+	File              string `arg:"" help:"routes.yaml file" type:"existingfile"`
+	SkipTemplateCheck bool   `help:"Do not check templates against the active theme"`
+}
+
+// Run executes the upload subcommand of the routes command
+func (c *RoutesUploadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Validate before anything is uploaded
+	data, err := os.ReadFile(c.File)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+	issues, err := validateRoutes(client, data, c.SkipTemplateCheck)
+	if err != nil {
+		return err
+	}
+	if err := printRouteIssues(formatter, issues); err != nil {
+		return err
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("replace routes.yaml with %s", c.File)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Upload file (Ghost only accepts the .yaml extension)
+	if err := client.UploadRoutes(bytes.NewReader(data), "routes.yaml"); err != nil {
+		return fmt.Errorf("failed to upload routes: %w", err)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("uploaded routes: %s", c.File))
+
+	return nil
+}
+
+// RoutesValidateCmd is the command to validate routes.yaml
+type RoutesValidateCmd struct {
+	File              string `arg:"" help:"routes.yaml file" type:"existingfile"`
+	SkipTemplateCheck bool   `help:"Do not check templates against the active theme (no API access needed)"`
+}
+
+// Run executes the validate subcommand of the routes command
+func (c *RoutesValidateCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Read file
+	data, err := os.ReadFile(c.File)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	// Get API client (only needed for the template check)
+	var client *ghostapi.Client
+	if !c.SkipTemplateCheck {
+		client, err = getAPIClient(root)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	issues, err := validateRoutes(client, data, c.SkipTemplateCheck)
+	if err != nil {
+		return err
+	}
+
+	// Output as-is if JSON format
+	if root.JSON {
+		if err := formatter.Print(issues); err != nil {
+			return err
+		}
+		if routes.HasErrors(issues) {
+			return &ExitError{Code: 1, Err: fmt.Errorf("routes.yaml has errors")}
+		}
+		return nil
+	}
+
+	if err := printRouteIssues(formatter, issues); err != nil {
+		return err
+	}
+	formatter.PrintMessage(fmt.Sprintf("%s: OK", c.File))
+
+	return nil
+}
+
+// validateRoutes validates routes.yaml
+//
+// Unless skipTemplates is set, referenced templates are checked against the
+// custom templates of the active theme (missing ones are warnings).
+func validateRoutes(client *ghostapi.Client, data []byte, skipTemplates bool) ([]routes.Issue, error) {
+	file, err := routes.Parse(data)
+	if err != nil {
+		return nil, &ExitError{Code: 1, Err: err}
+	}
+
+	// Get templates of the active theme
+	var templates []string
+	if !skipTemplates {
+		templates, err = activeThemeTemplates(client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	issues := file.Validate(templates)
+	if issues == nil {
+		issues = []routes.Issue{}
+	}
+	return issues, nil
+}
+
+// printRouteIssues prints validation issues and fails on errors
+func printRouteIssues(formatter *outfmt.Formatter, issues []routes.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	headers := []string{"Line", "Location", "Severity", "Message"}
+	rows := make([][]string, len(issues))
+	for i, issue := range issues {
+		rows[i] = []string{
+			fmt.Sprintf("%d", issue.Line),
+			issue.Location,
+			string(issue.Severity),
+			issue.Message,
+		}
+	}
+	if err := formatter.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	if routes.HasErrors(issues) {
+		return &ExitError{Code: 1, Err: fmt.Errorf("routes.yaml has errors")}
+	}
+	return nil
+}
+
+// activeThemeTemplates returns the custom template filenames of the active theme
+//
+// Ghost does not list standard templates (index.hbs, post.hbs, ...).
+func activeThemeTemplates(client *ghostapi.Client) ([]string, error) {
+	response, err := client.ListThemes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list themes: %w", err)
+	}
+
+	for _, theme := range response.Themes {
+		if !theme.Active {
+			continue
+		}
+		templates := make([]string, len(theme.Templates))
+		for i, template := range theme.Templates {
+			templates[i] = template.Filename
+		}
+		return templates, nil
+	}
+
+	return nil, fmt.Errorf("no active theme found")
+}
@@ -0,0 +1,37 @@
+/**
+ * routes_test.go
+ * Test code for routes management commands
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestRoutesCmd_StructExists verifies that routes command structs exist
+func TestRoutesCmd_StructExists(t *testing.T) {
+	// Verify that routes commands are defined
+	_ = &RoutesDownloadCmd{}
+	_ = &RoutesUploadCmd{}
+	_ = &RoutesValidateCmd{}
+}
+
+// TestRoutesValidateCmd_SchemaErrorWithoutAPI verifies schema validation works offline
+func TestRoutesValidateCmd_SchemaErrorWithoutAPI(t *testing.T) {
+	// Create routes.yaml with an invalid permalink
+	path := filepath.Join(t.TempDir(), "routes.yaml")
+	content := "routes:\ncollections:\n  /:\n    permalink: /posts/\ntaxonomies:\n  tag: /tag/{slug}/\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	cmd := &RoutesValidateCmd{File: path, SkipTemplateCheck: true}
+	err := cmd.Run(context.Background(), &RootFlags{JSON: true})
+	if ExitCode(err) != 1 {
+		t.Errorf("ExitCode = %d (err: %v); want 1", ExitCode(err), err)
+	}
+}
@@ -0,0 +1,445 @@
+/**
+ * snapshot.go
+ * Snapshot commands
+ *
+ * Stores posts, pages, tags, members, and settings as individual JSON files
+ * in a content-addressed directory. Each run only refetches objects whose
+ * updated_at changed since the previous snapshot. Old snapshots are pruned
+ * by a daily/weekly retention policy.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+	"github.com/mtane0412/ghocli/internal/snapshot"
+)
+
+// snapshotPageSize is the page size used when listing objects
+const snapshotPageSize = 100
+
+// SnapshotCmd is the snapshot command
+type SnapshotCmd struct {
+	Create SnapshotCreateCmd `cmd:"" default:"withargs" help:"Take a snapshot (default)"`
+	List   SnapshotListCmd   `cmd:"" help:"List snapshots"`
+	Diff   SnapshotDiffCmd   `cmd:"" help:"Show what changed between two snapshots"`
+	Prune  SnapshotPruneCmd  `cmd:"" help:"Delete snapshots outside the retention policy"`
+}
+
+// SnapshotDirFlags are flags shared by snapshot commands
+type SnapshotDirFlags struct {
+	Dir string `help:"Snapshot directory" default:"snapshots" type:"path"`
+}
+
+// SnapshotRetentionFlags are retention policy flags
+type SnapshotRetentionFlags struct {
+	KeepDaily  int `help:"Keep the newest snapshot of each of the last N days (0 with --keep-weekly 0 keeps all)"`
+	KeepWeekly int `help:"Keep the newest snapshot of each of the last M weeks"`
+}
+
+// policy returns the retention policy
+func (f SnapshotRetentionFlags) policy() snapshot.Policy {
+	return snapshot.Policy{Daily: f.KeepDaily, Weekly: f.KeepWeekly}
+}
+
+// SnapshotCreateCmd is the command to take a snapshot
+type SnapshotCreateCmd struct {
+	SnapshotDirFlags       `embed:""`
+	SnapshotRetentionFlags `embed:""`
+}
+
+// Run executes the create subcommand of the snapshot command
+func (c *SnapshotCreateCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Load the previous snapshot to reuse unchanged objects
+	store := snapshot.NewStore(c.Dir)
+	previous, err := store.Latest()
+	if err != nil {
+		return err
+	}
+
+	site, err := client.GetSite()
+	if err != nil {
+		return fmt.Errorf("failed to get site information: %w", err)
+	}
+	manifest := snapshot.NewManifest(time.Now(), site.URL)
+
+	// Snapshot each collection
+	headers := []string{"Collection", "Objects", "Fetched", "Reused"}
+	rows := [][]string{}
+	for _, collection := range snapshotCollections() {
+		stats, err := takeSnapshot(client, store, previous, manifest, collection)
+		if err != nil {
+			return fmt.Errorf("failed to snapshot %s: %w", collection.name, err)
+		}
+		rows = append(rows, []string{
+			collection.name,
+			fmt.Sprintf("%d", stats.objects),
+			fmt.Sprintf("%d", stats.fetched),
+			fmt.Sprintf("%d", stats.objects-stats.fetched),
+		})
+	}
+
+	// Settings are a single object and always fetched
+	settings, err := client.GetSettings()
+	if err != nil {
+		return fmt.Errorf("failed to snapshot settings: %w", err)
+	}
+	hash, err := store.PutObject(settings.Settings)
+	if err != nil {
+		return err
+	}
+	manifest.Add("settings", "settings", snapshot.Entry{Hash: hash, Name: "settings"})
+	rows = append(rows, []string{"settings", "1", "1", "0"})
+
+	if err := store.SaveManifest(manifest); err != nil {
+		return err
+	}
+
+	// Apply retention policy
+	var pruned []string
+	if !c.policy().IsZero() {
+		pruned, _, err = pruneSnapshots(store, c.policy())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(map[string]interface{}{"snapshot": manifest.ID, "pruned": pruned})
+	}
+
+	if err := formatter.PrintTable(headers, rows); err != nil {
+		return err
+	}
+	formatter.PrintMessage(fmt.Sprintf("created snapshot %s in %s", manifest.ID, c.Dir))
+	if len(pruned) > 0 {
+		formatter.PrintMessage(fmt.Sprintf("pruned %d snapshots", len(pruned)))
+	}
+
+	return nil
+}
+
+// SnapshotListCmd is the command to list snapshots
+type SnapshotListCmd struct {
+	SnapshotDirFlags `embed:""`
+}
+
+// Run executes the list subcommand of the snapshot command
+func (c *SnapshotListCmd) Run(ctx context.Context, root *RootFlags) error {
+	store := snapshot.NewStore(c.Dir)
+	manifests, err := store.List()
+	if err != nil {
+		return err
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		ids := make([]string, len(manifests))
+		for i, m := range manifests {
+			ids[i] = m.ID
+		}
+		return formatter.Print(ids)
+	}
+
+	headers := []string{"ID", "Created", "Site", "Posts", "Pages", "Tags", "Members"}
+	rows := make([][]string, len(manifests))
+	for i, m := range manifests {
+		rows[i] = []string{
+			m.ID,
+			m.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+			m.Site,
+			fmt.Sprintf("%d", len(m.Collections["posts"])),
+			fmt.Sprintf("%d", len(m.Collections["pages"])),
+			fmt.Sprintf("%d", len(m.Collections["tags"])),
+			fmt.Sprintf("%d", len(m.Collections["members"])),
+		}
+	}
+	return formatter.PrintTable(headers, rows)
+}
+
+// SnapshotDiffCmd is the command to compare two snapshots
+type SnapshotDiffCmd struct {
+	SnapshotDirFlags `embed:""`
+
+	From string `arg:"" help:"Older snapshot ID (or unique prefix)"`
+	To   string `arg:"" help:"Newer snapshot ID (or unique prefix, 'latest')"`
+}
+
+// Run executes the diff subcommand of the snapshot command
+func (c *SnapshotDiffCmd) Run(ctx context.Context, root *RootFlags) error {
+	store := snapshot.NewStore(c.Dir)
+	from, err := store.LoadManifest(c.From)
+	if err != nil {
+		return err
+	}
+	to, err := store.LoadManifest(c.To)
+	if err != nil {
+		return err
+	}
+
+	changes := store.Diff(from, to)
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(changes)
+	}
+
+	if len(changes) == 0 {
+		formatter.PrintMessage(fmt.Sprintf("no changes between %s and %s", from.ID, to.ID))
+		return nil
+	}
+
+	headers := []string{"Collection", "Change", "Name", "ID", "Fields"}
+	rows := make([][]string, len(changes))
+	for i, change := range changes {
+		rows[i] = []string{
+			change.Collection,
+			string(change.Kind),
+			change.Name,
+			change.ID,
+			strings.Join(change.Fields, ", "),
+		}
+	}
+	return formatter.PrintTable(headers, rows)
+}
+
+// SnapshotPruneCmd is the command to apply the retention policy
+type SnapshotPruneCmd struct {
+	SnapshotDirFlags       `embed:""`
+	SnapshotRetentionFlags `embed:""`
+}
+
+// Run executes the prune subcommand of the snapshot command
+func (c *SnapshotPruneCmd) Run(ctx context.Context, root *RootFlags) error {
+	if c.policy().IsZero() {
+		return fmt.Errorf("specify --keep-daily and/or --keep-weekly")
+	}
+
+	store := snapshot.NewStore(c.Dir)
+	pruned, removed, err := pruneSnapshots(store, c.policy())
+	if err != nil {
+		return err
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(map[string]interface{}{"pruned": pruned, "objects_removed": removed})
+	}
+
+	formatter.PrintMessage(fmt.Sprintf("pruned %d snapshots, removed %d unreferenced objects", len(pruned), removed))
+	return nil
+}
+
+// pruneSnapshots deletes snapshots outside the policy and collects garbage
+//
+// Returns the deleted snapshot IDs and the number of removed objects.
+func pruneSnapshots(store *snapshot.Store, policy snapshot.Policy) ([]string, int, error) {
+	manifests, err := store.List()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	keep := snapshot.Retain(manifests, policy, time.Local)
+	pruned := []string{}
+	for _, m := range manifests {
+		if keep[m.ID] {
+			continue
+		}
+		if err := store.DeleteManifest(m.ID); err != nil {
+			return pruned, 0, err
+		}
+		pruned = append(pruned, m.ID)
+	}
+
+	removed, err := store.GC()
+	return pruned, removed, err
+}
+
+// snapshotObject is an object fetched for a snapshot
+type snapshotObject struct {
+	id        string
+	updatedAt time.Time
+	name      string
+	value     interface{}
+}
+
+// snapshotCollection describes how to list and fetch one collection
+type snapshotCollection struct {
+	name string
+	// list fetches one page of objects; with ids set, only those objects are fetched in full
+	list func(client *ghostapi.Client, page int, ids []string) ([]snapshotObject, int, error)
+}
+
+// snapshotStats counts objects processed for one collection
+type snapshotStats struct {
+	objects int
+	fetched int
+}
+
+// snapshotCollections returns the collections stored in a snapshot
+func snapshotCollections() []snapshotCollection {
+	return []snapshotCollection{
+		{name: "posts", list: func(client *ghostapi.Client, page int, ids []string) ([]snapshotObject, int, error) {
+			opts := ghostapi.ListOptions{Status: "all", Limit: snapshotPageSize, Page: page}
+			if ids == nil {
+				opts.Fields = "id,updated_at"
+			} else {
+				opts.Filter = idFilter(ids)
+				opts.Include = "tags,authors"
+			}
+			resp, err := client.ListPosts(opts)
+			if err != nil {
+				return nil, 0, err
+			}
+			objects := make([]snapshotObject, len(resp.Posts))
+			for i, post := range resp.Posts {
+				objects[i] = snapshotObject{post.ID, post.UpdatedAt, post.Title, post}
+			}
+			return objects, resp.Meta.Pagination.Pages, nil
+		}},
+		{name: "pages", list: func(client *ghostapi.Client, page int, ids []string) ([]snapshotObject, int, error) {
+			opts := ghostapi.ListOptions{Status: "all", Limit: snapshotPageSize, Page: page}
+			if ids == nil {
+				opts.Fields = "id,updated_at"
+			} else {
+				opts.Filter = idFilter(ids)
+				opts.Include = "tags,authors"
+			}
+			resp, err := client.ListPages(opts)
+			if err != nil {
+				return nil, 0, err
+			}
+			objects := make([]snapshotObject, len(resp.Pages))
+			for i, p := range resp.Pages {
+				objects[i] = snapshotObject{p.ID, p.UpdatedAt, p.Title, p}
+			}
+			return objects, resp.Meta.Pagination.Pages, nil
+		}},
+		{name: "tags", list: func(client *ghostapi.Client, page int, ids []string) ([]snapshotObject, int, error) {
+			opts := ghostapi.TagListOptions{Limit: snapshotPageSize, Page: page}
+			if ids == nil {
+				opts.Fields = "id,updated_at"
+			} else {
+				opts.Filter = idFilter(ids)
+			}
+			resp, err := client.ListTags(opts)
+			if err != nil {
+				return nil, 0, err
+			}
+			objects := make([]snapshotObject, len(resp.Tags))
+			for i, tag := range resp.Tags {
+				objects[i] = snapshotObject{tag.ID, tag.UpdatedAt, tag.Name, tag}
+			}
+			return objects, resp.Meta.Pagination.Pages, nil
+		}},
+		{name: "members", list: func(client *ghostapi.Client, page int, ids []string) ([]snapshotObject, int, error) {
+			opts := ghostapi.MemberListOptions{Limit: snapshotPageSize, Page: page}
+			if ids == nil {
+				opts.Fields = "id,updated_at"
+			} else {
+				opts.Filter = idFilter(ids)
+			}
+			resp, err := client.ListMembers(opts)
+			if err != nil {
+				return nil, 0, err
+			}
+			objects := make([]snapshotObject, len(resp.Members))
+			for i, member := range resp.Members {
+				objects[i] = snapshotObject{member.ID, member.UpdatedAt, member.Email, member}
+			}
+			return objects, resp.Meta.Pagination.Pages, nil
+		}},
+	}
+}
+
+// takeSnapshot records one collection in the manifest
+//
+// All IDs and updated_at values are listed first; only objects that are new
+// or changed since the previous snapshot are fetched in full.
+func takeSnapshot(client *ghostapi.Client, store *snapshot.Store, previous, manifest *snapshot.Manifest, collection snapshotCollection) (snapshotStats, error) {
+	stats := snapshotStats{}
+
+	// List IDs and updated_at of all objects
+	changed := []string{}
+	for page := 1; ; page++ {
+		objects, pages, err := collection.list(client, page, nil)
+		if err != nil {
+			return stats, err
+		}
+		for _, object := range objects {
+			updatedAt := formatSnapshotTime(object.updatedAt)
+			entry, ok := previous.Lookup(collection.name, object.id)
+			if ok && entry.UpdatedAt == updatedAt && store.HasObject(entry.Hash) {
+				manifest.Add(collection.name, object.id, entry)
+				continue
+			}
+			changed = append(changed, object.id)
+		}
+		if page >= pages {
+			break
+		}
+	}
+
+	// Fetch changed objects in batches
+	for start := 0; start < len(changed); start += snapshotPageSize {
+		end := min(start+snapshotPageSize, len(changed))
+		objects, _, err := collection.list(client, 1, changed[start:end])
+		if err != nil {
+			return stats, err
+		}
+		for _, object := range objects {
+			hash, err := store.PutObject(object.value)
+			if err != nil {
+				return stats, err
+			}
+			manifest.Add(collection.name, object.id, snapshot.Entry{
+				Hash:      hash,
+				UpdatedAt: formatSnapshotTime(object.updatedAt),
+				Name:      object.name,
+			})
+			stats.fetched++
+		}
+	}
+
+	stats.objects = len(manifest.Collections[collection.name])
+	return stats, nil
+}
+
+// idFilter returns an NQL filter matching the given IDs
+func idFilter(ids []string) string {
+	return "id:[" + strings.Join(ids, ",") + "]"
+}
+
+// formatSnapshotTime formats updated_at for comparison between snapshots
+func formatSnapshotTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,107 @@
+/**
+ * snapshot_test.go
+ * Test code for snapshot commands
+ */
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/snapshot"
+)
+
+// TestSnapshotCmd_StructExists verifies that snapshot command structs exist
+func TestSnapshotCmd_StructExists(t *testing.T) {
+	// Verify that snapshot commands are defined
+	_ = &SnapshotCreateCmd{}
+	_ = &SnapshotListCmd{}
+	_ = &SnapshotDiffCmd{}
+	_ = &SnapshotPruneCmd{}
+}
+
+// TestSnapshotCmd_DefaultsToCreate verifies that bare `snapshot` takes a snapshot
+func TestSnapshotCmd_DefaultsToCreate(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	kctx, err := parser.Parse([]string{"snapshot", "--keep-daily", "7", "--keep-weekly", "4"})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if kctx.Command() != "snapshot create" {
+		t.Errorf("Command() = %q; want %q", kctx.Command(), "snapshot create")
+	}
+	if got := cli.Snapshot.Create.policy(); got != (snapshot.Policy{Daily: 7, Weekly: 4}) {
+		t.Errorf("policy() = %+v", got)
+	}
+}
+
+// TestTakeSnapshot_FetchesOnlyChangedObjects verifies incremental snapshots
+func TestTakeSnapshot_FetchesOnlyChangedObjects(t *testing.T) {
+	store := snapshot.NewStore(t.TempDir())
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	// Server state: "a" unchanged, "b" edited, "c" new ("d" was deleted)
+	server := map[string]time.Time{"a": t1, "b": t2, "c": t1}
+	fetched := [][]string{}
+	collection := snapshotCollection{
+		name: "posts",
+		list: func(client *ghostapi.Client, page int, ids []string) ([]snapshotObject, int, error) {
+			if ids == nil {
+				objects := []snapshotObject{}
+				for _, id := range []string{"a", "b", "c"} {
+					objects = append(objects, snapshotObject{id: id, updatedAt: server[id]})
+				}
+				return objects, 1, nil
+			}
+			fetched = append(fetched, ids)
+			objects := []snapshotObject{}
+			for _, id := range ids {
+				objects = append(objects, snapshotObject{id, server[id], "Post " + id, map[string]string{"id": id, "updated_at": server[id].String()}})
+			}
+			return objects, 1, nil
+		},
+	}
+
+	// Previous snapshot with "a", "b" (old), and "d"
+	previous := snapshot.NewManifest(t1, "")
+	for _, id := range []string{"a", "b", "d"} {
+		hash, _ := store.PutObject(map[string]string{"id": id, "updated_at": t1.String()})
+		previous.Add("posts", id, snapshot.Entry{Hash: hash, UpdatedAt: formatSnapshotTime(t1), Name: "Post " + id})
+	}
+
+	manifest := snapshot.NewManifest(t2, "")
+	stats, err := takeSnapshot(nil, store, previous, manifest, collection)
+	if err != nil {
+		t.Fatalf("takeSnapshot() error: %v", err)
+	}
+
+	if !reflect.DeepEqual(fetched, [][]string{{"b", "c"}}) {
+		t.Errorf("fetched = %v; want [[b c]]", fetched)
+	}
+	if stats.objects != 3 || stats.fetched != 2 {
+		t.Errorf("stats = %+v; want 3 objects, 2 fetched", stats)
+	}
+	if _, ok := manifest.Lookup("posts", "d"); ok {
+		t.Error("deleted object d is still in the snapshot")
+	}
+	if entry, _ := manifest.Lookup("posts", "a"); entry != previous.Collections["posts"]["a"] {
+		t.Errorf("unchanged object a = %+v; want reused entry", entry)
+	}
+}
+
+// TestIDFilter verifies the NQL filter for batch fetching
+func TestIDFilter(t *testing.T) {
+	if got := idFilter([]string{"a1", "b2"}); got != "id:[a1,b2]" {
+		t.Errorf("idFilter() = %q", got)
+	}
+}
@@ -0,0 +1,376 @@
+/**
+ * snippets.go
+ * Snippet management commands
+ *
+ * Provides functionality for listing, creating, updating, and deleting Ghost snippets.
+ * Content can be read from HTML, Markdown, or Lexical files.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/input"
+	"github.com/mtane0412/ghocli/internal/markdown"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+)
+
+// SnippetsCmd is the snippet management command
+type SnippetsCmd struct {
+	List   SnippetsListCmd   `cmd:"" help:"List snippets"`
+	Get    SnippetsInfoCmd   `cmd:"" help:"Show snippet information"`
+	Create SnippetsCreateCmd `cmd:"" help:"Create a snippet"`
+	Update SnippetsUpdateCmd `cmd:"" help:"Update a snippet"`
+	Delete SnippetsDeleteCmd `cmd:"" help:"Delete a snippet"`
+}
+
+// SnippetsListCmd is the command to retrieve snippet list
+type SnippetsListCmd struct {
+	Limit  int    `help:"Number of snippets to retrieve" short:"l" aliases:"max,n" default:"15"`
+	Page   int    `help:"Page number" short:"p" default:"1"`
+	Filter string `help:"Filter condition" aliases:"where,w"`
+}
+
+// Run executes the list subcommand of the snippets command
+func (c *SnippetsListCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get snippet list
+	response, err := client.ListSnippets(ghostapi.SnippetListOptions{
+		Limit:  c.Limit,
+		Page:   c.Page,
+		Filter: c.Filter,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list snippets: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(response.Snippets)
+	}
+
+	// Output in table format
+	headers := []string{"ID", "Name", "Created", "Updated"}
+	rows := make([][]string, len(response.Snippets))
+	for i, snippet := range response.Snippets {
+		rows[i] = []string{
+			snippet.ID,
+			snippet.Name,
+			snippet.CreatedAt.Format("2006-01-02"),
+			snippet.UpdatedAt.Format("2006-01-02"),
+		}
+	}
+
+	return formatter.PrintTable(headers, rows)
+}
+
+// SnippetsInfoCmd is the command to show snippet information
+type SnippetsInfoCmd struct {
+	ID string `arg:"" help:"Snippet ID"`
+}
+
+// Run executes the info subcommand of the snippets command
+func (c *SnippetsInfoCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get snippet
+	snippet, err := client.GetSnippet(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get snippet: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(snippet)
+	}
+
+	// Output in key/value format (no headers)
+	rows := [][]string{
+		{"id", snippet.ID},
+		{"name", snippet.Name},
+		{"created", snippet.CreatedAt.Format("2006-01-02 15:04:05")},
+		{"updated", snippet.UpdatedAt.Format("2006-01-02 15:04:05")},
+		{"lexical", snippet.Lexical},
+	}
+
+	if err := formatter.PrintKeyValue(rows); err != nil {
+		return err
+	}
+
+	return formatter.Flush()
+}
+
+// SnippetsCreateCmd is the command to create snippet
+type SnippetsCreateCmd struct {
+	Name     string `help:"Snippet name" short:"n" required:""`
+	HTML     string `help:"Snippet content (HTML)" short:"c"`
+	Markdown string `help:"Snippet content (Markdown)" short:"m"`
+	Lexical  string `help:"Snippet content (Lexical JSON)" short:"x"`
+	File     string `help:"Read content from file (auto-detect format)" type:"existingfile"`
+}
+
+// Run executes the create subcommand of the snippets command
+func (c *SnippetsCreateCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Resolve content to Lexical JSON
+	lexical, err := snippetLexical(c.File, c.HTML, c.Markdown, c.Lexical)
+	if err != nil {
+		return err
+	}
+	if lexical == "" {
+		return fmt.Errorf("snippet content is required (--file, --html, --markdown, or --lexical)")
+	}
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Create new snippet
+	created, err := client.CreateSnippet(&ghostapi.Snippet{
+		Name:    c.Name,
+		Lexical: lexical,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create snippet: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	if !root.JSON {
+		formatter.PrintMessage(fmt.Sprintf("created snippet: %s (ID: %s)", created.Name, created.ID))
+	}
+
+	// Also output snippet information if JSON format
+	if root.JSON {
+		return formatter.Print(created)
+	}
+
+	return nil
+}
+
+// SnippetsUpdateCmd is the command to update snippet
+type SnippetsUpdateCmd struct {
+	ID       string `arg:"" help:"Snippet ID"`
+	Name     string `help:"Snippet name" short:"n"`
+	HTML     string `help:"Snippet content (HTML)" short:"c"`
+	Markdown string `help:"Snippet content (Markdown)" short:"m"`
+	Lexical  string `help:"Snippet content (Lexical JSON)" short:"x"`
+	File     string `help:"Read content from file (auto-detect format)" type:"existingfile"`
+}
+
+// Run executes the update subcommand of the snippets command
+func (c *SnippetsUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Resolve content to Lexical JSON
+	lexical, err := snippetLexical(c.File, c.HTML, c.Markdown, c.Lexical)
+	if err != nil {
+		return err
+	}
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get existing snippet
+	existing, err := client.GetSnippet(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get snippet: %w", err)
+	}
+
+	// Apply updates
+	updateSnippet := &ghostapi.Snippet{
+		Name:    existing.Name,
+		Lexical: existing.Lexical,
+	}
+	if c.Name != "" {
+		updateSnippet.Name = c.Name
+	}
+	if lexical != "" {
+		updateSnippet.Lexical = lexical
+	}
+
+	// Update snippet
+	updated, err := client.UpdateSnippet(c.ID, updateSnippet)
+	if err != nil {
+		return fmt.Errorf("failed to update snippet: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	if !root.JSON {
+		formatter.PrintMessage(fmt.Sprintf("updated snippet: %s (ID: %s)", updated.Name, updated.ID))
+	}
+
+	// Also output snippet information if JSON format
+	if root.JSON {
+		return formatter.Print(updated)
+	}
+
+	return nil
+}
+
+// SnippetsDeleteCmd is the command to delete snippet
+type SnippetsDeleteCmd struct {
+	ID string `arg:"" help:"Snippet ID"`
+}
+
+// Run executes the delete subcommand of the snippets command
+func (c *SnippetsDeleteCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get snippet information to build confirmation message
+	snippet, err := client.GetSnippet(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get snippet: %w", err)
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("delete snippet '%s' (ID: %s)", snippet.Name, c.ID)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Delete snippet
+	if err := client.DeleteSnippet(c.ID); err != nil {
+		return fmt.Errorf("failed to delete snippet: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("deleted snippet (ID: %s)", c.ID))
+
+	return nil
+}
+
+// ========================================
+// Helper functions
+// ========================================
+
+// snippetLexical resolves snippet content from a file or inline flags to Lexical JSON
+//
+// Snippets are stored as Lexical in the editor's clipboard form
+// ({"namespace":"KoenigEditor","nodes":[...]}), so HTML and Markdown are
+// wrapped in an HTML card and Lexical documents are converted to nodes.
+// Returns an empty string when no content is specified.
+func snippetLexical(file, htmlContent, markdownContent, lexicalContent string) (string, error) {
+	// Auto-detect format when file is specified
+	if file != "" {
+		fileContent, format, err := input.ReadContentWithFormat(file, "")
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+
+		switch format {
+		case input.FormatMarkdown:
+			markdownContent = fileContent
+		case input.FormatLexical:
+			lexicalContent = fileContent
+		default:
+			// Treat HTML and unknown formats as HTML
+			htmlContent = fileContent
+		}
+	}
+
+	if lexicalContent != "" {
+		return snippetFromLexical(lexicalContent)
+	}
+
+	// Convert Markdown to HTML
+	if markdownContent != "" {
+		converted, err := markdown.ConvertToHTML(markdownContent)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert markdown to HTML: %w", err)
+		}
+		htmlContent = converted
+	}
+
+	if htmlContent == "" {
+		return "", nil
+	}
+
+	card, err := json.Marshal(map[string]interface{}{
+		"type":    "html",
+		"version": 1,
+		"html":    htmlContent,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to build Lexical JSON: %w", err)
+	}
+	return snippetJSON([]json.RawMessage{card})
+}
+
+// snippetNamespace is the namespace of the editor's clipboard form used by snippets
+const snippetNamespace = "KoenigEditor"
+
+// snippetDocument is the Lexical form snippets are stored in
+type snippetDocument struct {
+	Namespace string            `json:"namespace"`
+	Nodes     []json.RawMessage `json:"nodes"`
+}
+
+// snippetFromLexical accepts a snippet as-is and converts a Lexical document
+// ({"root":{"children":[...]}}) to a snippet of its top-level nodes
+func snippetFromLexical(content string) (string, error) {
+	var parsed struct {
+		Namespace string            `json:"namespace"`
+		Nodes     []json.RawMessage `json:"nodes"`
+		Root      *struct {
+			Children []json.RawMessage `json:"children"`
+		} `json:"root"`
+	}
+	if err := json.Unmarshal([]byte(content), &parsed); err != nil {
+		return "", fmt.Errorf("invalid Lexical JSON: %w", err)
+	}
+
+	switch {
+	case parsed.Namespace != "" && parsed.Nodes != nil:
+		return content, nil
+	case parsed.Root != nil:
+		return snippetJSON(parsed.Root.Children)
+	}
+	return "", fmt.Errorf("invalid Lexical JSON: expected a snippet (namespace and nodes) or a document (root)")
+}
+
+// snippetJSON builds a snippet from Lexical nodes
+func snippetJSON(nodes []json.RawMessage) (string, error) {
+	if nodes == nil {
+		nodes = []json.RawMessage{}
+	}
+	data, err := json.Marshal(snippetDocument{Namespace: snippetNamespace, Nodes: nodes})
+	if err != nil {
+		return "", fmt.Errorf("failed to build Lexical JSON: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,88 @@
+/**
+ * snippets_test.go
+ * Test code for snippet management commands
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSnippetsCmd_StructExists verifies that snippet command structs exist
+func TestSnippetsCmd_StructExists(t *testing.T) {
+	// Verify that snippet commands are defined
+	_ = &SnippetsListCmd{}
+	_ = &SnippetsInfoCmd{}
+	_ = &SnippetsCreateCmd{}
+	_ = &SnippetsUpdateCmd{}
+	_ = &SnippetsDeleteCmd{}
+}
+
+// TestSnippetLexical_MarkdownFileWrappedInHTMLCard verifies Markdown files become an HTML card in the clipboard form
+func TestSnippetLexical_MarkdownFileWrappedInHTMLCard(t *testing.T) {
+	// Create Markdown file
+	path := filepath.Join(t.TempDir(), "cta.md")
+	if err := os.WriteFile(path, []byte("**Subscribe** today"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	lexical, err := snippetLexical(path, "", "", "")
+	if err != nil {
+		t.Fatalf("snippetLexical() error: %v", err)
+	}
+
+	// Verify HTML card contains converted HTML
+	var doc struct {
+		Namespace string `json:"namespace"`
+		Nodes     []struct {
+			Type string `json:"type"`
+			HTML string `json:"html"`
+		} `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(lexical), &doc); err != nil {
+		t.Fatalf("invalid Lexical JSON: %v", err)
+	}
+	if doc.Namespace != "KoenigEditor" {
+		t.Errorf("namespace = %q; want KoenigEditor", doc.Namespace)
+	}
+	if len(doc.Nodes) != 1 || doc.Nodes[0].Type != "html" {
+		t.Fatalf("nodes = %+v; want a single html card", doc.Nodes)
+	}
+	if !strings.Contains(doc.Nodes[0].HTML, "<strong>Subscribe</strong>") {
+		t.Errorf("html = %q; want converted Markdown", doc.Nodes[0].HTML)
+	}
+	if strings.Contains(lexical, `"root"`) {
+		t.Errorf("lexical = %s; want the clipboard form without root", lexical)
+	}
+}
+
+// TestSnippetLexical_LexicalPassThrough verifies snippets are used as-is and documents are converted
+func TestSnippetLexical_LexicalPassThrough(t *testing.T) {
+	snippet := `{"namespace":"KoenigEditor","nodes":[{"type":"paragraph","children":[]}]}`
+	lexical, err := snippetLexical("", "", "", snippet)
+	if err != nil {
+		t.Fatalf("snippetLexical() error: %v", err)
+	}
+	if lexical != snippet {
+		t.Errorf("lexical = %q; want input unchanged", lexical)
+	}
+
+	// A document's top-level nodes become the snippet nodes
+	lexical, err = snippetLexical("", "", "", `{"root":{"type":"root","children":[{"type":"horizontalrule","version":1}]}}`)
+	if err != nil {
+		t.Fatalf("snippetLexical() error: %v", err)
+	}
+	if want := `{"namespace":"KoenigEditor","nodes":[{"type":"horizontalrule","version":1}]}`; lexical != want {
+		t.Errorf("lexical = %s; want %s", lexical, want)
+	}
+
+	// Invalid JSON is rejected
+	if _, err := snippetLexical("", "", "", `{"root":`); err == nil {
+		t.Error("expected error for invalid Lexical JSON")
+	}
+}
@@ -0,0 +1,533 @@
+/**
+ * sync.go
+ * Two-way sync between a Markdown directory and the site
+ *
+ * "sync pull" writes every post and page as a Markdown file with front
+ * matter (including the ID and updated_at), "sync push" creates or updates
+ * only what changed locally, and "sync status" shows what would change.
+ * Posts edited on the site since the last pull are not overwritten without
+ * --force.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mtane0412/ghocli/internal/contentsync"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/outfmt"
+)
+
+// SyncCmd is the sync command
+type SyncCmd struct {
+	Pull   SyncPullCmd   `cmd:"" help:"Write posts and pages to Markdown files"`
+	Push   SyncPushCmd   `cmd:"" help:"Create or update posts and pages from changed Markdown files"`
+	Status SyncStatusCmd `cmd:"" help:"Show what pull and push would change"`
+}
+
+// SyncDirFlags are flags shared by sync commands
+type SyncDirFlags struct {
+	Dir string `arg:"" help:"Sync directory (posts/ and pages/ inside)" type:"path"`
+}
+
+// syncResult is the outcome for one file
+type syncResult struct {
+	Action string `json:"action"`
+	Kind   string `json:"kind"`
+	ID     string `json:"id,omitempty"`
+	Path   string `json:"path"`
+	Title  string `json:"title,omitempty"`
+}
+
+// SyncStatusCmd is the command to show sync status
+type SyncStatusCmd struct {
+	SyncDirFlags `embed:""`
+}
+
+// Run executes the status subcommand of the sync command
+func (c *SyncStatusCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	s, err := newSyncSession(client, c.Dir, root.Force)
+	if err != nil {
+		return err
+	}
+	changes := contentsync.Compare(s.local, s.remote, s.state)
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(changes)
+	}
+
+	if len(changes) == 0 {
+		formatter.PrintMessage("everything up to date")
+		return nil
+	}
+	headers := []string{"Status", "Type", "Path", "Title"}
+	rows := make([][]string, len(changes))
+	for i, change := range changes {
+		rows[i] = []string{change.Status, change.Kind, change.Path, change.Title}
+	}
+	return formatter.PrintTable(headers, rows)
+}
+
+// SyncPullCmd is the command to pull posts and pages into a directory
+type SyncPullCmd struct {
+	SyncDirFlags `embed:""`
+}
+
+// Run executes the pull subcommand of the sync command
+func (c *SyncPullCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	results, conflicts, err := syncPull(client, c.Dir, root.Force)
+	if err != nil {
+		return err
+	}
+	return printSyncResults(root, results, conflicts)
+}
+
+// syncPull writes posts and pages changed on the site to dir
+func syncPull(client *ghostapi.Client, dir string, force bool) ([]syncResult, int, error) {
+	s, err := newSyncSession(client, dir, force)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var results []syncResult
+	conflicts := 0
+	for _, change := range contentsync.Compare(s.local, s.remote, s.state) {
+		result := syncResult{Kind: change.Kind, ID: change.ID, Path: change.Path, Title: change.Title}
+		switch change.Status {
+		case contentsync.StatusRemoteNew, contentsync.StatusRemoteModified, contentsync.StatusDeletedLocally:
+			result.Action = "pulled"
+		case contentsync.StatusConflict:
+			if !force {
+				result.Action = "skipped (conflict, use --force to overwrite local changes)"
+				conflicts++
+				break
+			}
+			result.Action = "pulled (local changes overwritten)"
+		case contentsync.StatusDeletedRemotely:
+			result.Action = "skipped (deleted on the site)"
+		default:
+			// Local changes are kept for push
+			continue
+		}
+
+		if strings.HasPrefix(result.Action, "pulled") {
+			doc, err := syncKinds[change.Kind].fetch(client, change.ID)
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed to get %s %s: %w", change.Kind, change.ID, err)
+			}
+			if change.Status == contentsync.StatusRemoteNew {
+				result.Path = s.newPath(change.Kind, doc.Slug, doc.ID)
+			}
+			if err := s.write(result.Path, change.Kind, doc); err != nil {
+				return nil, 0, err
+			}
+			result.Title = doc.Title
+		}
+		results = append(results, result)
+	}
+
+	if err := s.save(); err != nil {
+		return nil, 0, err
+	}
+	return results, conflicts, nil
+}
+
+// SyncPushCmd is the command to push changed files to the site
+type SyncPushCmd struct {
+	SyncDirFlags `embed:""`
+
+	NoUploadImages bool `help:"Do not upload local images referenced by the content or feature image"`
+}
+
+// Run executes the push subcommand of the sync command
+func (c *SyncPushCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	results, conflicts, err := syncPush(ctx, client, c.Dir, root.Force, c.NoUploadImages)
+	if err != nil {
+		return err
+	}
+	return printSyncResults(root, results, conflicts)
+}
+
+// syncPush creates or updates posts and pages from files changed in dir
+func syncPush(ctx context.Context, client *ghostapi.Client, dir string, force, noUploadImages bool) ([]syncResult, int, error) {
+	s, err := newSyncSession(client, dir, force)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var results []syncResult
+	conflicts := 0
+	for _, change := range contentsync.Compare(s.local, s.remote, s.state) {
+		result := syncResult{Kind: change.Kind, ID: change.ID, Path: change.Path, Title: change.Title}
+		kind := syncKinds[change.Kind]
+
+		var existing *syncDocument
+		switch change.Status {
+		case contentsync.StatusNew:
+			result.Action = "created"
+		case contentsync.StatusModified, contentsync.StatusConflict:
+			// Check again right before updating (the list may be stale)
+			existing, err = kind.fetch(client, change.ID)
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed to get %s %s: %w", change.Kind, change.ID, err)
+			}
+			if syncTime(existing.UpdatedAt) != s.localByPath[change.Path].UpdatedAt && !force {
+				result.Action = "skipped (changed on the site since the last pull, use --force to overwrite)"
+				conflicts++
+				results = append(results, result)
+				continue
+			}
+			result.Action = "updated"
+		case contentsync.StatusRemoteModified:
+			result.Action = "skipped (changed on the site, run sync pull)"
+			results = append(results, result)
+			continue
+		default:
+			continue
+		}
+
+		doc, err := s.push(ctx, client, change, existing, noUploadImages)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to push %s: %w", change.Path, err)
+		}
+		result.ID, result.Title = doc.ID, doc.Title
+		results = append(results, result)
+	}
+
+	if err := s.save(); err != nil {
+		return nil, 0, err
+	}
+	return results, conflicts, nil
+}
+
+// printSyncResults prints pull/push results; conflicts make the command fail
+func printSyncResults(root *RootFlags, results []syncResult, conflicts int) error {
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		if err := formatter.Print(results); err != nil {
+			return err
+		}
+	} else if len(results) == 0 {
+		formatter.PrintMessage("everything up to date")
+	} else {
+		headers := []string{"Action", "Type", "Path", "Title"}
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			rows[i] = []string{r.Action, r.Kind, r.Path, r.Title}
+		}
+		if err := formatter.PrintTable(headers, rows); err != nil {
+			return err
+		}
+	}
+
+	if conflicts > 0 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("%d files skipped because of conflicts", conflicts)}
+	}
+	return nil
+}
+
+// syncSession holds the local files, remote list, and state of a sync directory
+type syncSession struct {
+	dir         string
+	state       *contentsync.State
+	local       []contentsync.LocalFile
+	localByPath map[string]contentsync.LocalFile
+	remote      []contentsync.Remote
+}
+
+// newSyncSession loads the directory state and lists posts and pages on the site
+func newSyncSession(client *ghostapi.Client, dir string, force bool) (*syncSession, error) {
+	state, err := contentsync.LoadState(dir)
+	if err != nil {
+		return nil, err
+	}
+	if state.Site != "" && state.Site != client.BaseURL() && !force {
+		return nil, fmt.Errorf("%s is synced with %s, not %s (use --force to switch sites)", dir, state.Site, client.BaseURL())
+	}
+	state.Site = client.BaseURL()
+
+	local, err := contentsync.Scan(dir)
+	if err != nil {
+		return nil, err
+	}
+	s := &syncSession{dir: dir, state: state, local: local, localByPath: map[string]contentsync.LocalFile{}}
+	for _, file := range local {
+		s.localByPath[file.Path] = file
+	}
+
+	for _, name := range contentsync.Kinds {
+		for page, pages := 1, 1; page <= pages; page++ {
+			var remote []contentsync.Remote
+			remote, pages, err = syncKinds[name].list(client, page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", contentsync.KindDir(name), err)
+			}
+			s.remote = append(s.remote, remote...)
+		}
+	}
+	return s, nil
+}
+
+// newPath returns a free file path for a pulled post or page
+func (s *syncSession) newPath(kind, slug, id string) string {
+	path := contentsync.KindDir(kind) + "/" + slug + ".md"
+	if _, err := os.Stat(filepath.Join(s.dir, filepath.FromSlash(path))); err == nil {
+		path = contentsync.KindDir(kind) + "/" + slug + "-" + id + ".md"
+	}
+	return path
+}
+
+// write renders a post or page as Markdown with front matter and records it
+func (s *syncSession) write(path, kind string, doc *syncDocument) error {
+	fm := doc.fields.frontMatter()
+	fm.ID, fm.UpdatedAt = doc.ID, syncTime(doc.UpdatedAt)
+	content, err := renderMarkdown(doc.HTML, doc.Lexical, fm)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(s.dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	s.state.Entries[doc.ID] = &contentsync.Entry{Kind: kind, Path: path, UpdatedAt: fm.UpdatedAt, Hash: contentsync.Hash(content)}
+	return nil
+}
+
+// push creates or updates the post or page of a changed file, then records
+// the new ID and updated_at in its front matter. Updates treat the file as
+// the full state, as edit does.
+func (s *syncSession) push(ctx context.Context, client *ghostapi.Client, change contentsync.Change, existing *syncDocument, noUploadImages bool) (*syncDocument, error) {
+	file := filepath.Join(s.dir, filepath.FromSlash(change.Path))
+	content, err := loadContent(ctx, client, file, "", "", "", &ContentMetaFlags{NoUploadImages: noUploadImages})
+	if err != nil {
+		return nil, err
+	}
+	meta, err := resolveContentMeta(content.FrontMatter, "", "", ContentMetaFlags{})
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil && meta.Title == "" {
+		return nil, fmt.Errorf("title is required in front matter")
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", change.Path, err)
+	}
+
+	// Like edit, leave the body alone when only the front matter changed
+	if existing != nil {
+		unchanged, err := sameBody(existing, string(data))
+		if err != nil {
+			return nil, err
+		}
+		if unchanged {
+			content.HTML, content.Lexical = "", ""
+		}
+	}
+
+	doc, err := syncKinds[change.Kind].save(client, existing, meta, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Point the file at the saved post so the next push updates it
+	updated := contentsync.SetFrontMatterKeys(string(data), [][2]string{{"id", doc.ID}, {"updated_at", syncTime(doc.UpdatedAt)}})
+	if err := os.WriteFile(file, []byte(updated), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", change.Path, err)
+	}
+	s.state.Entries[doc.ID] = &contentsync.Entry{Kind: change.Kind, Path: change.Path, UpdatedAt: syncTime(doc.UpdatedAt), Hash: contentsync.Hash(updated)}
+	return doc, nil
+}
+
+// save writes the sync state
+func (s *syncSession) save() error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	return s.state.Save(s.dir)
+}
+
+// syncTime formats an updated_at timestamp as stored in front matter
+func syncTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// syncDocument is a post or page fetched for sync
+type syncDocument struct {
+	ID, Title, Slug string
+	UpdatedAt       time.Time
+	HTML, Lexical   string
+	fields          contentFields
+}
+
+// syncKind describes how to list, fetch, and save one content type
+type syncKind struct {
+	list  func(client *ghostapi.Client, page int) ([]contentsync.Remote, int, error)
+	fetch func(client *ghostapi.Client, id string) (*syncDocument, error)
+	// save creates (existing == nil) or updates a post or page. An update
+	// treats the front matter as complete (fields left out are cleared) and
+	// leaves the body alone when content has neither HTML nor Lexical.
+	save func(client *ghostapi.Client, existing *syncDocument, meta *contentMeta, content *loadedContent) (*syncDocument, error)
+}
+
+// syncKinds are the synced content types by kind
+var syncKinds = map[string]syncKind{
+	"post": {
+		list: func(client *ghostapi.Client, page int) ([]contentsync.Remote, int, error) {
+			resp, err := client.ListPosts(ghostapi.ListOptions{Status: "all", Limit: snapshotPageSize, Page: page, Fields: "id,slug,title,updated_at"})
+			if err != nil {
+				return nil, 0, err
+			}
+			remote := make([]contentsync.Remote, len(resp.Posts))
+			for i, p := range resp.Posts {
+				remote[i] = contentsync.Remote{Kind: "post", ID: p.ID, Slug: p.Slug, Title: p.Title, UpdatedAt: syncTime(p.UpdatedAt)}
+			}
+			return remote, resp.Meta.Pagination.Pages, nil
+		},
+		fetch: func(client *ghostapi.Client, id string) (*syncDocument, error) {
+			post, err := client.GetPost(id)
+			if err != nil {
+				return nil, err
+			}
+			return postDocument(post), nil
+		},
+		save: func(client *ghostapi.Client, existing *syncDocument, meta *contentMeta, content *loadedContent) (*syncDocument, error) {
+			post := &ghostapi.Post{Status: "draft"}
+			var cleared []string
+			if existing != nil {
+				post = &ghostapi.Post{
+					Title:     existing.Title,
+					Slug:      existing.Slug,
+					Status:    *existing.fields.Status,
+					UpdatedAt: existing.UpdatedAt, // For optimistic locking
+				}
+				cleared = meta.replace(postFields(post))
+			} else {
+				meta.apply(postFields(post))
+			}
+			opts := contentSaveOptions(content, &post.HTML, &post.Lexical)
+			opts.Clear = cleared
+
+			var saved *ghostapi.Post
+			var err error
+			if existing == nil {
+				saved, err = client.CreatePostWithOptions(post, opts)
+			} else {
+				saved, err = client.UpdatePostWithOptions(existing.ID, post, opts)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return postDocument(saved), nil
+		},
+	},
+	"page": {
+		list: func(client *ghostapi.Client, page int) ([]contentsync.Remote, int, error) {
+			resp, err := client.ListPages(ghostapi.ListOptions{Status: "all", Limit: snapshotPageSize, Page: page, Fields: "id,slug,title,updated_at"})
+			if err != nil {
+				return nil, 0, err
+			}
+			remote := make([]contentsync.Remote, len(resp.Pages))
+			for i, p := range resp.Pages {
+				remote[i] = contentsync.Remote{Kind: "page", ID: p.ID, Slug: p.Slug, Title: p.Title, UpdatedAt: syncTime(p.UpdatedAt)}
+			}
+			return remote, resp.Meta.Pagination.Pages, nil
+		},
+		fetch: func(client *ghostapi.Client, id string) (*syncDocument, error) {
+			page, err := client.GetPage(id)
+			if err != nil {
+				return nil, err
+			}
+			return pageDocument(page), nil
+		},
+		save: func(client *ghostapi.Client, existing *syncDocument, meta *contentMeta, content *loadedContent) (*syncDocument, error) {
+			page := &ghostapi.Page{Status: "draft"}
+			var cleared []string
+			if existing != nil {
+				page = &ghostapi.Page{
+					Title:     existing.Title,
+					Slug:      existing.Slug,
+					Status:    *existing.fields.Status,
+					UpdatedAt: existing.UpdatedAt, // For optimistic locking
+				}
+				cleared = meta.replace(pageFields(page))
+			} else {
+				meta.apply(pageFields(page))
+			}
+			opts := contentSaveOptions(content, &page.HTML, &page.Lexical)
+			opts.Clear = cleared
+
+			var saved *ghostapi.Page
+			var err error
+			if existing == nil {
+				saved, err = client.CreatePageWithOptions(page, opts)
+			} else {
+				saved, err = client.UpdatePageWithOptions(existing.ID, page, opts)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return pageDocument(saved), nil
+		},
+	},
+}
+
+// contentSaveOptions sets the loaded content and returns the save options
+// (HTML without Lexical is converted on the server)
+func contentSaveOptions(content *loadedContent, html, lexical *string) ghostapi.CreateOptions {
+	if content.HTML != "" {
+		*html = content.HTML
+	}
+	if content.Lexical != "" {
+		*lexical = content.Lexical
+	}
+	if content.HTML != "" && content.Lexical == "" {
+		return ghostapi.CreateOptions{Source: "html"}
+	}
+	return ghostapi.CreateOptions{}
+}
+
+// postDocument wraps a post for sync
+func postDocument(p *ghostapi.Post) *syncDocument {
+	return &syncDocument{ID: p.ID, Title: p.Title, Slug: p.Slug, UpdatedAt: p.UpdatedAt, HTML: p.HTML, Lexical: p.Lexical, fields: postFields(p)}
+}
+
+// pageDocument wraps a page for sync
+func pageDocument(p *ghostapi.Page) *syncDocument {
+	return &syncDocument{ID: p.ID, Title: p.Title, Slug: p.Slug, UpdatedAt: p.UpdatedAt, HTML: p.HTML, Lexical: p.Lexical, fields: pageFields(p)}
+}
@@ -0,0 +1,239 @@
+/**
+ * sync_test.go
+ * Test code for sync commands
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/mtane0412/ghocli/internal/ghostapi"
+	"github.com/mtane0412/ghocli/internal/markdown"
+)
+
+// TestSyncCmd_StructExists verifies that sync command structs exist
+func TestSyncCmd_StructExists(t *testing.T) {
+	// Verify that sync commands are defined
+	_ = &SyncPullCmd{}
+	_ = &SyncPushCmd{}
+	_ = &SyncStatusCmd{}
+}
+
+// TestSyncCmd_ParsesDirAndForce verifies the directory argument and the global --force flag
+func TestSyncCmd_ParsesDirAndForce(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	dir := t.TempDir()
+	kctx, err := parser.Parse([]string{"sync", "push", dir, "--force"})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if kctx.Command() != "sync push <dir>" {
+		t.Errorf("Command() = %q; want %q", kctx.Command(), "sync push <dir>")
+	}
+	if cli.Sync.Push.Dir != filepath.Clean(dir) || !cli.Force {
+		t.Errorf("Dir = %q, Force = %v", cli.Sync.Push.Dir, cli.Force)
+	}
+}
+
+// TestSyncTime verifies that timestamps are stored in UTC
+func TestSyncTime(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 12, 0, 0, 500000000, time.FixedZone("JST", 9*60*60))
+	if got := syncTime(ts); got != "2024-01-02T03:00:00.5Z" {
+		t.Errorf("syncTime() = %q", got)
+	}
+}
+
+// TestContentSaveOptions verifies that HTML-only content is converted on the server
+func TestContentSaveOptions(t *testing.T) {
+	html, lexical := "<p>old</p>", `{"root":{}}`
+	opts := contentSaveOptions(&loadedContent{HTML: "<p>new</p>"}, &html, &lexical)
+	if opts.Source != "html" || html != "<p>new</p>" {
+		t.Errorf("HTML only: opts = %+v, html = %q", opts, html)
+	}
+
+	opts = contentSaveOptions(&loadedContent{Lexical: `{"root":{"children":[]}}`}, &html, &lexical)
+	if opts.Source != "" || lexical != `{"root":{"children":[]}}` {
+		t.Errorf("Lexical: opts = %+v, lexical = %q", opts, lexical)
+	}
+}
+
+// fakeGhost is a minimal Ghost Admin API for posts (pages are always empty)
+type fakeGhost struct {
+	posts   map[string]*ghostapi.Post
+	updates []ghostapi.Post              // Bodies of PUT requests
+	fields  []map[string]json.RawMessage // Raw fields of PUT requests (to see nulls and omitted fields)
+}
+
+// newFakeGhost starts a fake Ghost server and returns a client for it
+func newFakeGhost(t *testing.T, posts ...*ghostapi.Post) (*fakeGhost, *ghostapi.Client) {
+	t.Helper()
+	g := &fakeGhost{posts: map[string]*ghostapi.Post{}}
+	for _, p := range posts {
+		g.posts[p.ID] = p
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/ghost/api/admin/posts/"), "/")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/ghost/api/admin/pages/"):
+			fmt.Fprint(w, `{"pages":[],"meta":{"pagination":{"pages":1}}}`)
+		case r.Method == http.MethodGet && id == "":
+			var list []*ghostapi.Post
+			for _, p := range g.posts {
+				list = append(list, p)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"posts": list, "meta": map[string]interface{}{"pagination": map[string]int{"pages": 1}}})
+		case r.Method == http.MethodGet && g.posts[id] != nil:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"posts": []*ghostapi.Post{g.posts[id]}})
+		case r.Method == http.MethodPut && g.posts[id] != nil:
+			var body struct {
+				Posts []json.RawMessage `json:"posts"`
+			}
+			var update ghostapi.Post
+			var fields map[string]json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Posts) != 1 ||
+				json.Unmarshal(body.Posts[0], &update) != nil || json.Unmarshal(body.Posts[0], &fields) != nil {
+				http.Error(w, "bad request", http.StatusBadRequest)
+				return
+			}
+			g.updates = append(g.updates, update)
+			g.fields = append(g.fields, fields)
+
+			// Like Ghost, keep the fields the request leaves out
+			updated := *g.posts[id]
+			_ = json.Unmarshal(body.Posts[0], &updated)
+			updated.ID = id
+			updated.UpdatedAt = g.posts[id].UpdatedAt.Add(time.Minute)
+			g.posts[id] = &updated
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"posts": []*ghostapi.Post{&updated}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ghostapi.NewClient(server.URL, "keyid", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return g, client
+}
+
+// TestSync_PullEditPushKeepsContent verifies that editing one line of a
+// pulled file pushes the rest of the post unchanged
+func TestSync_PullEditPushKeepsContent(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	lexicalJSON, err := markdown.ConvertToLexical(realisticMarkdown)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	const id = "65a1b2c3d4e5f60718293a4b"
+	ghost, client := newFakeGhost(t, &ghostapi.Post{
+		ID: id, Title: "Snake_case & co", Slug: "snake-case", Status: "published",
+		Lexical: lexicalJSON, UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	dir := t.TempDir()
+
+	if _, conflicts, err := syncPull(client, dir, false); err != nil || conflicts != 0 {
+		t.Fatalf("syncPull() conflicts = %d, error = %v", conflicts, err)
+	}
+	file := filepath.Join(dir, "posts", "snake-case.md")
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("pulled file missing: %v", err)
+	}
+	edited := strings.Replace(string(data), "item\\_one", "item\\_1", 1)
+	if edited == string(data) {
+		t.Fatalf("pulled file does not contain the list item:\n%s", data)
+	}
+	if err := os.WriteFile(file, []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, conflicts, err := syncPush(context.Background(), client, dir, false, true); err != nil || conflicts != 0 {
+		t.Fatalf("syncPush() conflicts = %d, error = %v", conflicts, err)
+	}
+	if len(ghost.updates) != 1 {
+		t.Fatalf("got %d updates; want 1", len(ghost.updates))
+	}
+	want, _ := markdown.ConvertToLexical(strings.Replace(realisticMarkdown, "item_one", "item_1", 1))
+	if got := ghost.updates[0]; got.Lexical != want || got.Title != "Snake_case & co" {
+		t.Errorf("pushed title %q, Lexical:\n%s\nwant:\n%s", got.Title, got.Lexical, want)
+	}
+
+	// A second push has nothing to do
+	if results, _, err := syncPush(context.Background(), client, dir, false, true); err != nil || len(results) != 0 {
+		t.Errorf("second syncPush() = %+v, %v; want no changes", results, err)
+	}
+}
+
+// TestSync_PushFrontMatterOnly verifies that a push of a file whose body is
+// unchanged leaves the body alone and clears removed front matter fields
+func TestSync_PushFrontMatterOnly(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	const lexicalJSON = `{"root":{"children":[` +
+		`{"children":[{"detail":0,"format":8,"mode":"normal","style":"","text":"underlined","type":"extended-text","version":1}],"direction":"ltr","format":"","indent":0,"type":"paragraph","version":1},` +
+		`{"html":"<p>Hi {first_name}</p>","type":"email","version":1}` +
+		`],"direction":"ltr","format":"","indent":0,"type":"root","version":1}}`
+	const id = "65a1b2c3d4e5f60718293a4b"
+	ghost, client := newFakeGhost(t, &ghostapi.Post{
+		ID: id, Title: "Welcome", Slug: "welcome", Status: "draft", CustomExcerpt: "Short",
+		Tags: []ghostapi.Tag{{Name: "news"}}, Lexical: lexicalJSON, UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	dir := t.TempDir()
+
+	if _, conflicts, err := syncPull(client, dir, false); err != nil || conflicts != 0 {
+		t.Fatalf("syncPull() conflicts = %d, error = %v", conflicts, err)
+	}
+	file := filepath.Join(dir, "posts", "welcome.md")
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("pulled file missing: %v", err)
+	}
+	edited := strings.Replace(string(data), "title: Welcome", "title: Hello", 1)
+	edited = strings.Replace(edited, "custom_excerpt: Short\n", "", 1)
+	edited = strings.Replace(edited, "tags:\n    - news\n", "", 1)
+	if strings.Contains(edited, "Welcome") || strings.Contains(edited, "Short") || strings.Contains(edited, "news") {
+		t.Fatalf("unexpected pulled file:\n%s", data)
+	}
+	if err := os.WriteFile(file, []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, conflicts, err := syncPush(context.Background(), client, dir, false, true); err != nil || conflicts != 0 {
+		t.Fatalf("syncPush() conflicts = %d, error = %v", conflicts, err)
+	}
+	if len(ghost.fields) != 1 {
+		t.Fatalf("got %d updates; want 1", len(ghost.fields))
+	}
+	fields := ghost.fields[0]
+	if value, ok := fields["lexical"]; ok {
+		t.Errorf("update sent lexical = %s; want it left out", value)
+	}
+	if got := string(fields["custom_excerpt"]); got != "null" {
+		t.Errorf("custom_excerpt = %q; want null", got)
+	}
+	if got := string(fields["tags"]); got != "[]" {
+		t.Errorf("tags = %q; want []", got)
+	}
+	if got := ghost.posts[id]; got.Title != "Hello" || got.Lexical != lexicalJSON {
+		t.Errorf("saved title %q, Lexical:\n%s\nwant:\n%s", got.Title, got.Lexical, lexicalJSON)
+	}
+}
@@ -2,24 +2,37 @@
  * themes.go
  * Theme management commands
  *
- * Provides functionality for listing, uploading, and activating Ghost themes.
+ * Provides functionality for listing, uploading, downloading, and activating
+ * Ghost themes, local validation of themes before upload, and a development
+ * loop that re-uploads a theme directory whenever it changes.
  */
 
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/mtane0412/ghocli/internal/ghostapi"
 	"github.com/mtane0412/ghocli/internal/outfmt"
+	"github.com/mtane0412/ghocli/internal/themecheck"
+	"github.com/mtane0412/ghocli/internal/ui"
+	"github.com/mtane0412/ghocli/internal/watch"
 )
 
 // ThemesCmd is the theme management command
 type ThemesCmd struct {
 	List     ThemesListCmd     `cmd:"" help:"List themes"`
 	Upload   ThemesUploadCmd   `cmd:"" help:"Upload a theme"`
+	Download ThemesDownloadCmd `cmd:"" help:"Download an installed theme"`
+	Check    ThemesCheckCmd    `cmd:"" help:"Validate a theme directory or zip locally"`
+	Dev      ThemesDevCmd      `cmd:"" help:"Watch a theme directory and re-upload it on every change"`
 	Activate ThemesActivateCmd `cmd:"" help:"Activate a theme"`
 	Delete   ThemesDeleteCmd   `cmd:"" help:"Delete a theme"`
 
@@ -81,11 +94,19 @@ func (c *ThemesListCmd) Run(ctx context.Context, root *RootFlags) error {
 
 // ThemesUploadCmd is the command to upload theme
 type ThemesUploadCmd struct {
-	File string `arg:"" help:"Theme zip file path" type:"existingfile"`
+	File  string `arg:"" help:"Theme zip file path" type:"existingfile"`
+	Check bool   `help:"Validate the theme locally first and refuse to upload on errors"`
 }
 
 // Run executes the upload subcommand of the themes command
 func (c *ThemesUploadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Validate before anything is uploaded
+	if c.Check {
+		if err := checkTheme(outfmt.NewFormatter(os.Stderr, root.GetOutputMode()), c.File); err != nil {
+			return err
+		}
+	}
+
 	// Get API client
 	client, err := getAPIClient(root)
 	if err != nil {
@@ -130,6 +151,217 @@ func (c *ThemesUploadCmd) Run(ctx context.Context, root *RootFlags) error {
 	return nil
 }
 
+// ThemesDownloadCmd is the command to download an installed theme
+type ThemesDownloadCmd struct {
+	Name   string `arg:"" help:"Theme name"`
+	Output string `help:"Output file path (default: <name>.zip; '-' for stdout)" short:"o"`
+}
+
+// Run executes the download subcommand of the themes command
+func (c *ThemesDownloadCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Download theme
+	data, err := client.DownloadTheme(c.Name)
+	if err != nil {
+		return fmt.Errorf("failed to download theme: %w", err)
+	}
+
+	// Write to stdout
+	if c.Output == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	// Write to file
+	output := c.Output
+	if output == "" {
+		output = c.Name + ".zip"
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("downloaded theme: %s", output))
+
+	return nil
+}
+
+// ThemesCheckCmd is the command to validate a theme locally
+type ThemesCheckCmd struct {
+	Path string `arg:"" help:"Theme directory or zip file" type:"path"`
+}
+
+// Run executes the check subcommand of the themes command
+func (c *ThemesCheckCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		theme, err := themecheck.Load(c.Path)
+		if err != nil {
+			return err
+		}
+		issues := theme.Check()
+		if err := formatter.Print(issues); err != nil {
+			return err
+		}
+		if themecheck.HasErrors(issues) {
+			return &ExitError{Code: 1, Err: fmt.Errorf("theme has errors")}
+		}
+		return nil
+	}
+
+	if err := checkTheme(formatter, c.Path); err != nil {
+		return err
+	}
+	formatter.PrintMessage(fmt.Sprintf("%s: OK", c.Path))
+
+	return nil
+}
+
+// checkTheme validates a theme, prints any issues, and fails on errors
+func checkTheme(formatter *outfmt.Formatter, path string) error {
+	theme, err := themecheck.Load(path)
+	if err != nil {
+		return err
+	}
+
+	issues := theme.Check()
+	if len(issues) == 0 {
+		return nil
+	}
+
+	headers := []string{"File", "Severity", "Message"}
+	rows := make([][]string, len(issues))
+	for i, issue := range issues {
+		rows[i] = []string{issue.File, string(issue.Severity), issue.Message}
+	}
+	if err := formatter.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	if themecheck.HasErrors(issues) {
+		return &ExitError{Code: 1, Err: fmt.Errorf("theme has errors")}
+	}
+	return nil
+}
+
+// ThemesDevCmd is the command to develop a theme against a live site
+type ThemesDevCmd struct {
+	Dir      string        `arg:"" help:"Theme directory" type:"existingdir"`
+	Interval time.Duration `help:"How often to poll for changes" default:"500ms"`
+	Debounce time.Duration `help:"Quiet period after the last change before uploading" default:"300ms"`
+}
+
+// Run executes the dev subcommand of the themes command
+func (c *ThemesDevCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Check the durations before the first upload
+	opts := watch.Options{Interval: c.Interval, Debounce: c.Debounce, Skip: themecheck.IsIgnored}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid --interval or --debounce: %w", err)
+	}
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Retrieve UI from context
+	output := ui.FromContext(ctx)
+	if output == nil {
+		output = ui.NewOutput(os.Stdout, os.Stderr)
+	}
+
+	// Stop watching on Ctrl+C
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	// Upload once at startup, then on every change
+	c.deploy(client, output, nil)
+	output.PrintMessage(fmt.Sprintf("watching %s (Ctrl+C to stop)", c.Dir))
+
+	return watch.Poll(ctx, c.Dir, opts, func(changed []string) {
+		c.deploy(client, output, changed)
+	})
+}
+
+// deploy checks, packages, uploads, and activates the theme directory
+//
+// Errors are printed rather than returned so that the watcher keeps running.
+func (c *ThemesDevCmd) deploy(client *ghostapi.Client, output *ui.Output, changed []string) {
+	stamp := time.Now().Format("15:04:05")
+	fail := func(format string, args ...interface{}) {
+		output.PrintError(fmt.Sprintf("[%s] error: %s", stamp, fmt.Sprintf(format, args...)))
+	}
+
+	if len(changed) > 0 {
+		output.PrintMessage(fmt.Sprintf("[%s] changed: %s", stamp, summarizePaths(changed, 3)))
+	}
+
+	// Validate locally; errors would only be rejected by Ghost
+	theme, err := themecheck.LoadDir(c.Dir)
+	if err != nil {
+		fail("%v", err)
+		return
+	}
+	issues := theme.Check()
+	for _, issue := range issues {
+		// Warnings are shown on the first upload only to keep the loop quiet
+		if issue.Severity == themecheck.SeverityWarning && changed != nil {
+			continue
+		}
+		output.PrintError(fmt.Sprintf("[%s] %s: %s: %s", stamp, issue.Severity, issue.File, issue.Message))
+	}
+	if themecheck.HasErrors(issues) {
+		fail("theme has errors; not uploaded")
+		return
+	}
+
+	// Build zip in memory (Ghost names the theme after the zip file)
+	data, err := themecheck.BuildZip(c.Dir)
+	if err != nil {
+		fail("%v", err)
+		return
+	}
+	name := theme.Name()
+	if name == "" {
+		abs, _ := filepath.Abs(c.Dir)
+		name = filepath.Base(abs)
+	}
+
+	// Upload and activate
+	uploaded, err := client.UploadTheme(bytes.NewReader(data), name+".zip")
+	if err != nil {
+		fail("failed to upload theme: %v", err)
+		return
+	}
+	if _, err := client.ActivateTheme(uploaded.Name); err != nil {
+		fail("failed to activate theme: %v", err)
+		return
+	}
+
+	output.PrintMessage(fmt.Sprintf("[%s] uploaded and activated %s (%.1f KB)", stamp, uploaded.Name, float64(len(data))/1024))
+}
+
+// summarizePaths lists up to limit paths, noting how many more there are
+func summarizePaths(paths []string, limit int) string {
+	if len(paths) <= limit {
+		return strings.Join(paths, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(paths[:limit], ", "), len(paths)-limit)
+}
+
 // ThemesActivateCmd is the command to activate theme
 type ThemesActivateCmd struct {
 	Name string `arg:"" help:"Theme name"`
@@ -171,11 +403,19 @@ func (c *ThemesActivateCmd) Run(ctx context.Context, root *RootFlags) error {
 
 // ThemesInstallCmd is the command to upload and activate theme
 type ThemesInstallCmd struct {
-	File string `arg:"" help:"Path to theme zip file" type:"existingfile"`
+	File  string `arg:"" help:"Path to theme zip file" type:"existingfile"`
+	Check bool   `help:"Validate the theme locally first and refuse to upload on errors"`
 }
 
 // Run executes the install subcommand of the themes command
 func (c *ThemesInstallCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Validate before anything is uploaded
+	if c.Check {
+		if err := checkTheme(outfmt.NewFormatter(os.Stderr, root.GetOutputMode()), c.File); err != nil {
+			return err
+		}
+	}
+
 	// Get API client
 	client, err := getAPIClient(root)
 	if err != nil {
@@ -8,7 +8,14 @@
 package cmd
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
 )
 
 // TestThemesInstallCmd_StructExists verifies that ThemesInstallCmd struct exists
@@ -22,3 +29,64 @@ func TestThemesDeleteCmd_StructExists(t *testing.T) {
 	// Verify that ThemesDeleteCmd is defined
 	_ = &ThemesDeleteCmd{}
 }
+
+// TestThemesDownloadAndCheckCmd_StructExists verifies that download and check commands exist
+func TestThemesDownloadAndCheckCmd_StructExists(t *testing.T) {
+	// Verify that the commands are defined
+	_ = &ThemesDownloadCmd{}
+	_ = &ThemesCheckCmd{}
+}
+
+// TestThemesUploadCmd_CheckRefusesOnErrors verifies that --check stops before any API access
+func TestThemesUploadCmd_CheckRefusesOnErrors(t *testing.T) {
+	// Create a theme directory without required templates
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"name":"t","version":"1.0.0"}`), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	cmd := &ThemesUploadCmd{File: dir, Check: true}
+	err := cmd.Run(context.Background(), &RootFlags{JSON: true})
+	if ExitCode(err) != 1 {
+		t.Errorf("ExitCode = %d (err: %v); want 1", ExitCode(err), err)
+	}
+}
+
+// TestThemesDevCmd_ParseDurations verifies polling and debounce flags
+func TestThemesDevCmd_ParseDurations(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"themes", "dev", t.TempDir(), "--debounce", "1s"}); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if cli.Themes.Dev.Interval != 500*time.Millisecond || cli.Themes.Dev.Debounce != time.Second {
+		t.Errorf("Dev = %+v; want 500ms interval and 1s debounce", cli.Themes.Dev)
+	}
+}
+
+// TestThemesDevCmd_RejectsInvalidDurations verifies that bad durations fail before the API is used
+func TestThemesDevCmd_RejectsInvalidDurations(t *testing.T) {
+	for _, cmd := range []*ThemesDevCmd{
+		{Dir: t.TempDir(), Interval: 0, Debounce: 300 * time.Millisecond},
+		{Dir: t.TempDir(), Interval: 500 * time.Millisecond, Debounce: -time.Second},
+	} {
+		err := cmd.Run(context.Background(), &RootFlags{})
+		if err == nil || !strings.Contains(err.Error(), "invalid --interval or --debounce") {
+			t.Errorf("Run(%+v) error = %v; want invalid duration error", cmd, err)
+		}
+	}
+}
+
+// TestSummarizePaths verifies the changed-files summary
+func TestSummarizePaths(t *testing.T) {
+	if got := summarizePaths([]string{"a.hbs", "b.hbs"}, 3); got != "a.hbs, b.hbs" {
+		t.Errorf("summarizePaths() = %q", got)
+	}
+	if got := summarizePaths([]string{"a", "b", "c", "d", "e"}, 3); got != "a, b, c and 2 more" {
+		t.Errorf("summarizePaths() = %q", got)
+	}
+}
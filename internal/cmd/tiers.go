@@ -12,6 +12,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/mtane0412/ghocli/internal/ghostapi"
@@ -24,6 +25,10 @@ type TiersCmd struct {
 	Get    TiersInfoCmd   `cmd:"" help:"Show tier information"`
 	Create TiersCreateCmd `cmd:"" help:"Create a tier"`
 	Update TiersUpdateCmd `cmd:"" help:"Update a tier"`
+
+	Archive   TiersArchiveCmd   `cmd:"" help:"Archive a tier"`
+	Unarchive TiersUnarchiveCmd `cmd:"" help:"Unarchive a tier"`
+	Benefits  TiersBenefitsCmd  `cmd:"" help:"Manage tier benefits"`
 }
 
 // TiersListCmd is the command to retrieve tier list
@@ -122,8 +127,8 @@ func (c *TiersInfoCmd) Run(ctx context.Context, root *RootFlags) error {
 		{"Active", fmt.Sprintf("%t", tier.Active)},
 		{"Visibility", tier.Visibility},
 		{"Welcome Page URL", tier.WelcomePageURL},
-		{"Monthly Price", fmt.Sprintf("%d", tier.MonthlyPrice)},
-		{"Yearly Price", fmt.Sprintf("%d", tier.YearlyPrice)},
+		{"Monthly Price", formatPrice(tier.MonthlyPrice, tier.Currency)},
+		{"Yearly Price", formatPrice(tier.YearlyPrice, tier.Currency)},
 		{"Currency", tier.Currency},
 		{"Benefits", strings.Join(tier.Benefits, ", ")},
 		{"Created", tier.CreatedAt.Format("2006-01-02 15:04:05")},
@@ -157,7 +162,7 @@ func (c *TiersCreateCmd) Run(ctx context.Context, root *RootFlags) error {
 	// Confirm destructive operation
 	priceInfo := ""
 	if c.Type == "paid" {
-		priceInfo = fmt.Sprintf(" (monthly: %d %s, yearly: %d %s)", c.MonthlyPrice, c.Currency, c.YearlyPrice, c.Currency)
+		priceInfo = fmt.Sprintf(" (monthly: %s, yearly: %s)", formatPrice(c.MonthlyPrice, c.Currency), formatPrice(c.YearlyPrice, c.Currency))
 	}
 	action := fmt.Sprintf("create tier '%s'%s", c.Name, priceInfo)
 	if err := ConfirmDestructive(ctx, root, action); err != nil {
@@ -224,8 +229,20 @@ func (c *TiersUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 		return fmt.Errorf("failed to get tier: %w", err)
 	}
 
-	// Confirm destructive operation
+	// Confirm destructive operation (showing current and new prices)
 	action := fmt.Sprintf("update tier '%s' (ID: %s)", existingTier.Name, c.ID)
+	var priceChanges []string
+	if c.MonthlyPrice != nil && *c.MonthlyPrice != existingTier.MonthlyPrice {
+		priceChanges = append(priceChanges, fmt.Sprintf("monthly price from %s to %s",
+			formatPrice(existingTier.MonthlyPrice, existingTier.Currency), formatPrice(*c.MonthlyPrice, existingTier.Currency)))
+	}
+	if c.YearlyPrice != nil && *c.YearlyPrice != existingTier.YearlyPrice {
+		priceChanges = append(priceChanges, fmt.Sprintf("yearly price from %s to %s",
+			formatPrice(existingTier.YearlyPrice, existingTier.Currency), formatPrice(*c.YearlyPrice, existingTier.Currency)))
+	}
+	if len(priceChanges) > 0 {
+		action += ", changing " + strings.Join(priceChanges, " and ")
+	}
 	if err := ConfirmDestructive(ctx, root, action); err != nil {
 		return err
 	}
@@ -287,3 +304,356 @@ func (c *TiersUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 
 	return nil
 }
+
+// TiersArchiveCmd is the command to archive tier
+type TiersArchiveCmd struct {
+	ID string `arg:"" help:"Tier ID"`
+}
+
+// Run executes the archive subcommand of the tiers command
+func (c *TiersArchiveCmd) Run(ctx context.Context, root *RootFlags) error {
+	return setTierActive(ctx, root, c.ID, false)
+}
+
+// TiersUnarchiveCmd is the command to unarchive tier
+type TiersUnarchiveCmd struct {
+	ID string `arg:"" help:"Tier ID"`
+}
+
+// Run executes the unarchive subcommand of the tiers command
+func (c *TiersUnarchiveCmd) Run(ctx context.Context, root *RootFlags) error {
+	return setTierActive(ctx, root, c.ID, true)
+}
+
+// setTierActive archives or unarchives a tier after confirmation
+func setTierActive(ctx context.Context, root *RootFlags, id string, active bool) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get existing tier
+	existingTier, err := client.GetTier(id)
+	if err != nil {
+		return fmt.Errorf("failed to get tier: %w", err)
+	}
+
+	verb := "archive"
+	if active {
+		verb = "unarchive"
+	}
+	if existingTier.Type == "free" {
+		return fmt.Errorf("cannot %s the free tier", verb)
+	}
+	if existingTier.Active == active {
+		state := "archived"
+		if active {
+			state = "active"
+		}
+		return fmt.Errorf("tier '%s' is already %s", existingTier.Name, state)
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("%s tier '%s' (ID: %s)", verb, existingTier.Name, id)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Update tier
+	var updatedTier *ghostapi.Tier
+	if active {
+		updatedTier, err = client.UnarchiveTier(id)
+	} else {
+		updatedTier, err = client.ArchiveTier(id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s tier: %w", verb, err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(updatedTier)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("%sd tier: %s (ID: %s)", verb, updatedTier.Name, updatedTier.ID))
+
+	return nil
+}
+
+// ========================================
+// Benefits
+// ========================================
+
+// TiersBenefitsCmd is the tier benefits management command
+type TiersBenefitsCmd struct {
+	List    TiersBenefitsListCmd    `cmd:"" default:"withargs" help:"List tier benefits"`
+	Add     TiersBenefitsAddCmd     `cmd:"" help:"Add a benefit"`
+	Remove  TiersBenefitsRemoveCmd  `cmd:"" help:"Remove a benefit"`
+	Reorder TiersBenefitsReorderCmd `cmd:"" help:"Reorder benefits"`
+}
+
+// TiersBenefitsListCmd is the command to list tier benefits
+type TiersBenefitsListCmd struct {
+	ID string `arg:"" help:"Tier ID"`
+}
+
+// Run executes the list subcommand of the tiers benefits command
+func (c *TiersBenefitsListCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get tier
+	tier, err := client.GetTier(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get tier: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(tier.Benefits)
+	}
+
+	return printBenefits(formatter, tier.Benefits)
+}
+
+// TiersBenefitsAddCmd is the command to add a tier benefit
+type TiersBenefitsAddCmd struct {
+	ID       string `arg:"" help:"Tier ID"`
+	Benefit  string `arg:"" help:"Benefit text"`
+	Position int    `help:"Insert at this position (1-based, default: last)"`
+}
+
+// Run executes the add subcommand of the tiers benefits command
+func (c *TiersBenefitsAddCmd) Run(ctx context.Context, root *RootFlags) error {
+	return editTierBenefits(ctx, root, c.ID, func(tier *ghostapi.Tier) ([]string, string, error) {
+		benefits, err := insertBenefit(tier.Benefits, c.Benefit, c.Position)
+		return benefits, fmt.Sprintf("add benefit '%s' to tier '%s'", c.Benefit, tier.Name), err
+	})
+}
+
+// TiersBenefitsRemoveCmd is the command to remove a tier benefit
+type TiersBenefitsRemoveCmd struct {
+	ID      string `arg:"" help:"Tier ID"`
+	Benefit string `arg:"" help:"Benefit position (1-based) or exact text"`
+}
+
+// Run executes the remove subcommand of the tiers benefits command
+func (c *TiersBenefitsRemoveCmd) Run(ctx context.Context, root *RootFlags) error {
+	return editTierBenefits(ctx, root, c.ID, func(tier *ghostapi.Tier) ([]string, string, error) {
+		benefits, removed, err := removeBenefit(tier.Benefits, c.Benefit)
+		return benefits, fmt.Sprintf("remove benefit '%s' from tier '%s'", removed, tier.Name), err
+	})
+}
+
+// TiersBenefitsReorderCmd is the command to reorder tier benefits
+type TiersBenefitsReorderCmd struct {
+	ID    string `arg:"" help:"Tier ID"`
+	Order []int  `arg:"" help:"Current positions (1-based) in the new order, e.g. 3 1 2"`
+}
+
+// Run executes the reorder subcommand of the tiers benefits command
+func (c *TiersBenefitsReorderCmd) Run(ctx context.Context, root *RootFlags) error {
+	return editTierBenefits(ctx, root, c.ID, func(tier *ghostapi.Tier) ([]string, string, error) {
+		benefits, err := reorderBenefits(tier.Benefits, c.Order)
+		return benefits, fmt.Sprintf("reorder benefits of tier '%s'", tier.Name), err
+	})
+}
+
+// editTierBenefits fetches a tier, applies a benefit edit, confirms, and saves
+//
+// edit returns the new benefit list and the action description for confirmation.
+func editTierBenefits(ctx context.Context, root *RootFlags, id string, edit func(tier *ghostapi.Tier) ([]string, string, error)) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get existing tier
+	existingTier, err := client.GetTier(id)
+	if err != nil {
+		return fmt.Errorf("failed to get tier: %w", err)
+	}
+
+	// Apply edit
+	benefits, action, err := edit(existingTier)
+	if err != nil {
+		return err
+	}
+
+	// Confirm destructive operation
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Update tier
+	updatedTier, err := client.SetTierBenefits(id, benefits)
+	if err != nil {
+		return fmt.Errorf("failed to update tier benefits: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(updatedTier)
+	}
+
+	return printBenefits(formatter, updatedTier.Benefits)
+}
+
+// printBenefits outputs benefits as a numbered table
+func printBenefits(formatter *outfmt.Formatter, benefits []string) error {
+	headers := []string{"#", "Benefit"}
+	rows := make([][]string, len(benefits))
+	for i, benefit := range benefits {
+		rows[i] = []string{fmt.Sprintf("%d", i+1), benefit}
+	}
+
+	return formatter.PrintTable(headers, rows)
+}
+
+// insertBenefit inserts a benefit at a 1-based position (0 appends)
+func insertBenefit(benefits []string, benefit string, position int) ([]string, error) {
+	benefit = strings.TrimSpace(benefit)
+	if benefit == "" {
+		return nil, fmt.Errorf("benefit must not be empty")
+	}
+	for _, existing := range benefits {
+		if existing == benefit {
+			return nil, fmt.Errorf("benefit already exists: %s", benefit)
+		}
+	}
+	if position == 0 {
+		position = len(benefits) + 1
+	}
+	if position < 1 || position > len(benefits)+1 {
+		return nil, fmt.Errorf("position %d out of range (1-%d)", position, len(benefits)+1)
+	}
+
+	result := make([]string, 0, len(benefits)+1)
+	result = append(result, benefits[:position-1]...)
+	result = append(result, benefit)
+	result = append(result, benefits[position-1:]...)
+	return result, nil
+}
+
+// removeBenefit removes a benefit by 1-based position or exact text
+//
+// Returns the new list and the removed benefit.
+func removeBenefit(benefits []string, target string) ([]string, string, error) {
+	index := -1
+	if n, err := strconv.Atoi(target); err == nil {
+		if n < 1 || n > len(benefits) {
+			return nil, "", fmt.Errorf("position %d out of range (1-%d)", n, len(benefits))
+		}
+		index = n - 1
+	} else {
+		for i, benefit := range benefits {
+			if benefit == target {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, "", fmt.Errorf("benefit not found: %s", target)
+		}
+	}
+
+	removed := benefits[index]
+	result := make([]string, 0, len(benefits)-1)
+	result = append(result, benefits[:index]...)
+	result = append(result, benefits[index+1:]...)
+	return result, removed, nil
+}
+
+// reorderBenefits reorders benefits by a permutation of 1-based positions
+func reorderBenefits(benefits []string, order []int) ([]string, error) {
+	if len(order) != len(benefits) {
+		return nil, fmt.Errorf("order must list all %d positions, got %d", len(benefits), len(order))
+	}
+
+	seen := make(map[int]bool, len(order))
+	result := make([]string, 0, len(benefits))
+	for _, n := range order {
+		if n < 1 || n > len(benefits) {
+			return nil, fmt.Errorf("position %d out of range (1-%d)", n, len(benefits))
+		}
+		if seen[n] {
+			return nil, fmt.Errorf("position %d listed more than once", n)
+		}
+		seen[n] = true
+		result = append(result, benefits[n-1])
+	}
+	return result, nil
+}
+
+// ========================================
+// Price formatting
+// ========================================
+
+// zeroDecimalCurrencies are currencies whose smallest unit is the main unit (Stripe's list)
+var zeroDecimalCurrencies = map[string]bool{
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "JPY": true, "KMF": true,
+	"KRW": true, "MGA": true, "PYG": true, "RWF": true, "UGX": true, "VND": true,
+	"VUV": true, "XAF": true, "XOF": true, "XPF": true,
+}
+
+// currencySymbols are symbols for common currencies (others are shown with their code)
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"KRW": "₩",
+	"INR": "₹",
+}
+
+// formatPrice formats an amount in the smallest currency unit for display
+//
+// e.g. (500, "JPY") -> "¥500", (1999, "usd") -> "$19.99", (1000, "CHF") -> "10.00 CHF"
+func formatPrice(amount int, currency string) string {
+	currency = strings.ToUpper(currency)
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	var number string
+	if zeroDecimalCurrencies[currency] {
+		number = groupThousands(amount)
+	} else {
+		number = fmt.Sprintf("%s.%02d", groupThousands(amount/100), amount%100)
+	}
+
+	if symbol, ok := currencySymbols[currency]; ok {
+		return sign + symbol + number
+	}
+	if currency == "" {
+		return sign + number
+	}
+	return sign + number + " " + currency
+}
+
+// groupThousands formats a non-negative integer with comma separators
+func groupThousands(n int) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
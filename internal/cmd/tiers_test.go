@@ -6,7 +6,10 @@
 package cmd
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/alecthomas/kong"
 )
 
 // TestTiersInfoCmd_StructExists verifies that TiersInfoCmd struct exists
@@ -14,3 +17,105 @@ func TestTiersInfoCmd_StructExists(t *testing.T) {
 	// Verify that TiersInfoCmd is defined
 	_ = &TiersInfoCmd{}
 }
+
+// TestTiersLifecycleCmd_StructExists verifies that archive and benefit command structs exist
+func TestTiersLifecycleCmd_StructExists(t *testing.T) {
+	// Verify that lifecycle and benefit commands are defined
+	_ = &TiersArchiveCmd{}
+	_ = &TiersUnarchiveCmd{}
+	_ = &TiersBenefitsListCmd{}
+	_ = &TiersBenefitsAddCmd{}
+	_ = &TiersBenefitsRemoveCmd{}
+	_ = &TiersBenefitsReorderCmd{}
+}
+
+// TestTiersBenefitsReorderCmd_ParsesOrder verifies that reorder accepts positions as arguments
+func TestTiersBenefitsReorderCmd_ParsesOrder(t *testing.T) {
+	cli := &CLI{}
+	parser, err := kong.New(cli, kong.Name("gho"), kong.Exit(func(int) {}))
+	if err != nil {
+		t.Fatalf("failed to create Kong parser: %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"tiers", "benefits", "reorder", "tier-id", "3", "1", "2"}); err != nil {
+		t.Fatalf("failed to parse command line: %v", err)
+	}
+	if !reflect.DeepEqual(cli.Tiers.Benefits.Reorder.Order, []int{3, 1, 2}) {
+		t.Errorf("Order = %v; want [3 1 2]", cli.Tiers.Benefits.Reorder.Order)
+	}
+}
+
+// TestFormatPrice verifies currency-aware price formatting
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		amount   int
+		currency string
+		want     string
+	}{
+		{500, "JPY", "¥500"},
+		{1200000, "jpy", "¥1,200,000"},
+		{1999, "USD", "$19.99"},
+		{500, "usd", "$5.00"},
+		{123456, "EUR", "€1,234.56"},
+		{1000, "CHF", "10.00 CHF"},
+		{0, "USD", "$0.00"},
+	}
+	for _, tt := range tests {
+		if got := formatPrice(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("formatPrice(%d, %q) = %q; want %q", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+// TestInsertBenefit verifies inserting benefits at a position
+func TestInsertBenefit(t *testing.T) {
+	benefits := []string{"A", "B"}
+
+	got, err := insertBenefit(benefits, "C", 0)
+	if err != nil || !reflect.DeepEqual(got, []string{"A", "B", "C"}) {
+		t.Errorf("insertBenefit(append) = %v, %v; want [A B C]", got, err)
+	}
+	got, err = insertBenefit(benefits, "C", 1)
+	if err != nil || !reflect.DeepEqual(got, []string{"C", "A", "B"}) {
+		t.Errorf("insertBenefit(position 1) = %v, %v; want [C A B]", got, err)
+	}
+	if _, err := insertBenefit(benefits, "A", 0); err == nil {
+		t.Error("expected error for duplicate benefit")
+	}
+	if _, err := insertBenefit(benefits, "C", 4); err == nil {
+		t.Error("expected error for out-of-range position")
+	}
+}
+
+// TestRemoveBenefit verifies removing benefits by position or text
+func TestRemoveBenefit(t *testing.T) {
+	benefits := []string{"A", "B", "C"}
+
+	got, removed, err := removeBenefit(benefits, "2")
+	if err != nil || removed != "B" || !reflect.DeepEqual(got, []string{"A", "C"}) {
+		t.Errorf("removeBenefit(2) = %v, %q, %v; want [A C], B", got, removed, err)
+	}
+	got, removed, err = removeBenefit(benefits, "C")
+	if err != nil || removed != "C" || !reflect.DeepEqual(got, []string{"A", "B"}) {
+		t.Errorf("removeBenefit(C) = %v, %q, %v; want [A B], C", got, removed, err)
+	}
+	if _, _, err := removeBenefit(benefits, "D"); err == nil {
+		t.Error("expected error for unknown benefit")
+	}
+}
+
+// TestReorderBenefits verifies reordering requires a full permutation
+func TestReorderBenefits(t *testing.T) {
+	benefits := []string{"A", "B", "C"}
+
+	got, err := reorderBenefits(benefits, []int{3, 1, 2})
+	if err != nil || !reflect.DeepEqual(got, []string{"C", "A", "B"}) {
+		t.Errorf("reorderBenefits() = %v, %v; want [C A B]", got, err)
+	}
+	if _, err := reorderBenefits(benefits, []int{1, 2}); err == nil {
+		t.Error("expected error for incomplete order")
+	}
+	if _, err := reorderBenefits(benefits, []int{1, 1, 2}); err == nil {
+		t.Error("expected error for duplicate position")
+	}
+}
@@ -3,7 +3,8 @@
  * User management commands
  *
  * Provides functionality for retrieving and updating Ghost users (site administrators and contributors).
- * User creation and deletion should be done using the Ghost dashboard's invitation feature.
+ * New users are added through invitations (see invites.go).
+ * Role changes, suspension, and deletion require confirmation.
  */
 
 package cmd
@@ -12,6 +13,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/mtane0412/ghocli/internal/fields"
 	"github.com/mtane0412/ghocli/internal/ghostapi"
@@ -23,6 +25,12 @@ type UsersCmd struct {
 	List   UsersListCmd   `cmd:"" help:"List users"`
 	Get    UsersInfoCmd   `cmd:"" help:"Show user information"`
 	Update UsersUpdateCmd `cmd:"" help:"Update a user"`
+
+	Invite    UsersInviteCmd    `cmd:"" help:"Invite a new staff user"`
+	Role      UsersRoleCmd      `cmd:"" help:"Change a user's role"`
+	Suspend   UsersSuspendCmd   `cmd:"" help:"Suspend a user"`
+	Unsuspend UsersUnsuspendCmd `cmd:"" help:"Unsuspend a user"`
+	Delete    UsersDeleteCmd    `cmd:"" help:"Delete a user"`
 }
 
 // UsersListCmd is the command to retrieve user list
@@ -162,6 +170,7 @@ func (c *UsersInfoCmd) Run(ctx context.Context, root *RootFlags) error {
 		{"website", user.Website},
 		{"profile_image", user.ProfileImage},
 		{"cover_image", user.CoverImage},
+		{"status", user.Status},
 		{"created", user.CreatedAt.Format("2006-01-02 15:04:05")},
 		{"updated", user.UpdatedAt.Format("2006-01-02 15:04:05")},
 	}
@@ -256,3 +265,258 @@ func (c *UsersUpdateCmd) Run(ctx context.Context, root *RootFlags) error {
 
 	return nil
 }
+
+// UsersInviteCmd is the command to invite a staff user
+type UsersInviteCmd struct {
+	Email string `arg:"" help:"Email address to invite"`
+	Role  string `help:"Role (Administrator, Editor, Author, Contributor)" default:"Contributor"`
+}
+
+// Run executes the invite subcommand of the users command
+func (c *UsersInviteCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Resolve role
+	role, err := findAssignableRole(client, c.Role)
+	if err != nil {
+		return err
+	}
+
+	// Send invitation
+	invite, err := client.CreateInvite(c.Email, role.ID)
+	if err != nil {
+		return fmt.Errorf("failed to invite user: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(invite)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("invited %s as %s (invite ID: %s)", invite.Email, role.Name, invite.ID))
+
+	return nil
+}
+
+// UsersRoleCmd is the command to change a user's role
+type UsersRoleCmd struct {
+	ID   string `arg:"" help:"User ID or slug (use 'slug:user-slug' format for slug)"`
+	Role string `arg:"" help:"New role (Administrator, Editor, Author, Contributor)"`
+}
+
+// Run executes the role subcommand of the users command
+func (c *UsersRoleCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get existing user
+	user, err := client.GetUser(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Resolve role
+	role, err := findAssignableRole(client, c.Role)
+	if err != nil {
+		return err
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("change role of user '%s' from %s to %s", user.Name, userRoleNames(user), role.Name)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Update role
+	updatedUser, err := client.SetUserRole(user.ID, *role)
+	if err != nil {
+		return fmt.Errorf("failed to change role: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(updatedUser)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("changed role of user %s to %s", updatedUser.Name, role.Name))
+
+	return nil
+}
+
+// UsersSuspendCmd is the command to suspend a user
+type UsersSuspendCmd struct {
+	ID string `arg:"" help:"User ID or slug (use 'slug:user-slug' format for slug)"`
+}
+
+// Run executes the suspend subcommand of the users command
+func (c *UsersSuspendCmd) Run(ctx context.Context, root *RootFlags) error {
+	return setUserStatus(ctx, root, c.ID, "inactive")
+}
+
+// UsersUnsuspendCmd is the command to unsuspend a user
+type UsersUnsuspendCmd struct {
+	ID string `arg:"" help:"User ID or slug (use 'slug:user-slug' format for slug)"`
+}
+
+// Run executes the unsuspend subcommand of the users command
+func (c *UsersUnsuspendCmd) Run(ctx context.Context, root *RootFlags) error {
+	return setUserStatus(ctx, root, c.ID, "active")
+}
+
+// setUserStatus suspends ("inactive") or unsuspends ("active") a user after confirmation
+func setUserStatus(ctx context.Context, root *RootFlags, id, status string) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get existing user
+	user, err := client.GetUser(id)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	verb := "suspend"
+	if status == "active" {
+		verb = "unsuspend"
+	}
+	if user.Status == status {
+		return fmt.Errorf("user '%s' is already %s", user.Name, status)
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("%s user '%s' (%s)", verb, user.Name, user.Email)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Update status
+	updatedUser, err := client.SetUserStatus(user.ID, status)
+	if err != nil {
+		return fmt.Errorf("failed to %s user: %w", verb, err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output as-is if JSON format
+	if root.JSON {
+		return formatter.Print(updatedUser)
+	}
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("%sed user: %s (ID: %s)", verb, updatedUser.Name, updatedUser.ID))
+
+	return nil
+}
+
+// UsersDeleteCmd is the command to delete a user
+type UsersDeleteCmd struct {
+	ID         string `arg:"" help:"User ID or slug (use 'slug:user-slug' format for slug)"`
+	ReassignTo string `help:"Move the user's posts and pages to this user (ID or slug:user-slug)" required:""`
+}
+
+// Run executes the delete subcommand of the users command
+func (c *UsersDeleteCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return err
+	}
+
+	// Get user to delete and the new author
+	user, err := client.GetUser(c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	target, err := client.GetUser(c.ReassignTo)
+	if err != nil {
+		return fmt.Errorf("failed to get user to reassign to: %w", err)
+	}
+	if user.ID == target.ID {
+		return fmt.Errorf("cannot reassign content to the user being deleted")
+	}
+
+	// Confirm destructive operation
+	action := fmt.Sprintf("delete user '%s' (%s) and reassign their posts and pages to '%s'", user.Name, user.Email, target.Name)
+	if err := ConfirmDestructive(ctx, root, action); err != nil {
+		return err
+	}
+
+	// Move content before deleting (Ghost would otherwise assign it to the owner)
+	count, err := client.ReassignUserContent(user, target)
+	if err != nil {
+		return fmt.Errorf("failed to reassign content (%d items moved, user not deleted): %w", count, err)
+	}
+
+	// Delete user
+	if err := client.DeleteUser(user.ID); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Show success message
+	formatter.PrintMessage(fmt.Sprintf("reassigned %d posts and pages to %s", count, target.Name))
+	formatter.PrintMessage(fmt.Sprintf("deleted user: %s (ID: %s)", user.Name, user.ID))
+
+	return nil
+}
+
+// findAssignableRole finds an assignable role by name (case-insensitive) or ID
+func findAssignableRole(client *ghostapi.Client, nameOrID string) (*ghostapi.Role, error) {
+	roles, err := client.ListRoles(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	role := matchRole(roles, nameOrID)
+	if role == nil {
+		names := make([]string, len(roles))
+		for i, r := range roles {
+			names[i] = r.Name
+		}
+		return nil, fmt.Errorf("unknown or unassignable role: %s (available: %s)", nameOrID, strings.Join(names, ", "))
+	}
+
+	return role, nil
+}
+
+// matchRole returns the role matching a name (case-insensitive) or ID
+func matchRole(roles []ghostapi.Role, nameOrID string) *ghostapi.Role {
+	for i, role := range roles {
+		if role.ID == nameOrID || strings.EqualFold(role.Name, nameOrID) {
+			return &roles[i]
+		}
+	}
+	return nil
+}
+
+// userRoleNames returns the comma-separated role names of a user
+func userRoleNames(user *ghostapi.User) string {
+	if len(user.Roles) == 0 {
+		return "(none)"
+	}
+	names := make([]string, len(user.Roles))
+	for i, role := range user.Roles {
+		names[i] = role.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -7,6 +7,8 @@ package cmd
 
 import (
 	"testing"
+
+	"github.com/mtane0412/ghocli/internal/ghostapi"
 )
 
 // TestUsersInfoCmd_StructExists verifies that UsersInfoCmd struct exists
@@ -14,3 +16,34 @@ func TestUsersInfoCmd_StructExists(t *testing.T) {
 	// Verify that UsersInfoCmd is defined
 	_ = &UsersInfoCmd{}
 }
+
+// TestUsersAdminCmd_StructExists verifies that staff management command structs exist
+func TestUsersAdminCmd_StructExists(t *testing.T) {
+	// Verify that staff management commands are defined
+	_ = &UsersInviteCmd{}
+	_ = &UsersRoleCmd{}
+	_ = &UsersSuspendCmd{}
+	_ = &UsersUnsuspendCmd{}
+	_ = &UsersDeleteCmd{}
+	_ = &InvitesListCmd{}
+	_ = &InvitesRevokeCmd{}
+	_ = &RolesListCmd{}
+}
+
+// TestMatchRole verifies roles are matched by name (case-insensitive) or ID
+func TestMatchRole(t *testing.T) {
+	roles := []ghostapi.Role{
+		{ID: "r1", Name: "Administrator"},
+		{ID: "r2", Name: "Editor"},
+	}
+
+	if role := matchRole(roles, "editor"); role == nil || role.ID != "r2" {
+		t.Errorf("matchRole(editor) = %+v; want r2", role)
+	}
+	if role := matchRole(roles, "r1"); role == nil || role.Name != "Administrator" {
+		t.Errorf("matchRole(r1) = %+v; want Administrator", role)
+	}
+	if role := matchRole(roles, "Owner"); role != nil {
+		t.Errorf("matchRole(Owner) = %+v; want nil", role)
+	}
+}
@@ -2,7 +2,8 @@
  * webhooks.go
  * Webhook management commands
  *
- * Provides functionality for creating, updating, and deleting Ghost webhooks.
+ * Provides functionality for creating, updating, and deleting Ghost webhooks,
+ * and for sending signed test deliveries to any endpoint.
  * Note: Ghost API does not support List/Get operations for webhooks.
  */
 
@@ -12,6 +13,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/mtane0412/ghocli/internal/ghostapi"
 	"github.com/mtane0412/ghocli/internal/outfmt"
@@ -22,6 +24,9 @@ type WebhooksCmd struct {
 	Create WebhooksCreateCmd `cmd:"" help:"Create a webhook"`
 	Update WebhooksUpdateCmd `cmd:"" help:"Update a webhook"`
 	Delete WebhooksDeleteCmd `cmd:"" help:"Delete a webhook"`
+
+	// Test delivery
+	Trigger WebhooksTriggerCmd `cmd:"" help:"Send a signed sample webhook to an endpoint"`
 }
 
 // WebhooksCreateCmd is the command to create Webhook
@@ -161,3 +166,107 @@ func (c *WebhooksDeleteCmd) Run(ctx context.Context, root *RootFlags) error {
 
 	return nil
 }
+
+// ========================================
+// Test delivery
+// ========================================
+
+// WebhooksTriggerCmd is the command to send a sample webhook to an endpoint
+type WebhooksTriggerCmd struct {
+	Event  string `arg:"" help:"Webhook event (e.g., post.published, member.added)"`
+	Target string `help:"Target URL to deliver the webhook to" short:"t" required:""`
+	Secret string `help:"Secret used to sign the payload (X-Ghost-Signature)"`
+	Post   string `help:"Build the payload from this post or page (ID or slug)"`
+	Member string `help:"Build the payload from this member (ID)"`
+}
+
+// Run executes the trigger subcommand of the webhooks command
+func (c *WebhooksTriggerCmd) Run(ctx context.Context, root *RootFlags) error {
+	// Validate event against Ghost's event catalog
+	if !ghostapi.IsValidWebhookEvent(c.Event) {
+		return fmt.Errorf("unknown webhook event: %s (valid events: %s)", c.Event, strings.Join(ghostapi.WebhookEvents, ", "))
+	}
+
+	// Resolve the resource to send
+	resource, err := c.resolveResource(root)
+	if err != nil {
+		return err
+	}
+
+	// Build payload in Ghost's shape
+	payload, err := ghostapi.BuildWebhookPayload(c.Event, resource)
+	if err != nil {
+		return err
+	}
+
+	// Deliver webhook
+	delivery, err := ghostapi.SendWebhook(c.Target, c.Secret, c.Event, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+
+	// Create output formatter
+	formatter := outfmt.NewFormatter(os.Stdout, root.GetOutputMode())
+
+	// Output delivery result if JSON format
+	if root.JSON {
+		if err := formatter.Print(delivery); err != nil {
+			return err
+		}
+	} else {
+		formatter.PrintMessage(fmt.Sprintf("sent %s to %s (HTTP %d)", delivery.Event, delivery.TargetURL, delivery.StatusCode))
+		if delivery.Signature != "" {
+			formatter.PrintMessage(fmt.Sprintf("signature: %s", delivery.Signature))
+		}
+	}
+
+	// Treat non-2xx responses from the receiver as failures
+	if delivery.StatusCode < 200 || delivery.StatusCode >= 300 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("receiver responded with HTTP %d", delivery.StatusCode)}
+	}
+
+	return nil
+}
+
+// resolveResource fetches the real resource for the payload or falls back to a built-in fixture
+func (c *WebhooksTriggerCmd) resolveResource(root *RootFlags) (interface{}, error) {
+	resource := ghostapi.WebhookResource(c.Event)
+
+	if c.Post != "" && c.Member != "" {
+		return nil, fmt.Errorf("--post and --member cannot be used together")
+	}
+
+	// Use built-in fixtures when no real resource is specified
+	if c.Post == "" && c.Member == "" {
+		return ghostapi.WebhookFixture(c.Event), nil
+	}
+
+	// Get API client
+	client, err := getAPIClient(root)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.Post != "" && resource == "post":
+		post, err := client.GetPost(c.Post)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get post: %w", err)
+		}
+		return post, nil
+	case c.Post != "" && resource == "page":
+		page, err := client.GetPage(c.Post)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get page: %w", err)
+		}
+		return page, nil
+	case c.Member != "" && resource == "member":
+		member, err := client.GetMember(c.Member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get member: %w", err)
+		}
+		return member, nil
+	default:
+		return nil, fmt.Errorf("event %s does not carry the specified resource", c.Event)
+	}
+}
@@ -25,6 +25,9 @@ type Config struct {
 
 	// Sites is a mapping from alias to site URL
 	Sites map[string]string `json:"sites"`
+
+	// Markdown overrides the default Markdown options (e.g. "tables": false)
+	Markdown map[string]bool `json:"markdown,omitempty"`
 }
 
 // Load reads the configuration file from the specified path.
@@ -0,0 +1,253 @@
+/**
+ * contentsync.go
+ * Two-way sync state between a Markdown directory and a Ghost site
+ *
+ * Posts and pages are stored as Markdown files with front matter:
+ *   <dir>/posts/<slug>.md
+ *   <dir>/pages/<slug>.md
+ *   <dir>/.gho-sync.json   (state of the last pull/push)
+ *
+ * The state records, per ID, the file path, the remote updated_at, and the
+ * hash of the file as written. A file whose hash differs was edited
+ * locally; a remote updated_at that differs from the file's front matter
+ * means the post was edited on the site since the last pull.
+ */
+
+package contentsync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mtane0412/ghocli/internal/input"
+)
+
+// StateFile is the name of the sync state file inside the sync directory
+const StateFile = ".gho-sync.json"
+
+// Kinds are the synced content types and their directories
+var Kinds = []string{"post", "page"}
+
+// Entry is the state of one synced post or page
+type Entry struct {
+	Kind      string `json:"kind"`
+	Path      string `json:"path"`       // Relative to the sync directory (slash-separated)
+	UpdatedAt string `json:"updated_at"` // Remote updated_at at the last pull/push
+	Hash      string `json:"hash"`       // Hash of the file as last written
+}
+
+// State is the sync state of a directory
+type State struct {
+	Site    string            `json:"site,omitempty"`
+	Entries map[string]*Entry `json:"entries"` // By post/page ID
+}
+
+// LoadState reads the state of dir (empty if the directory was never synced)
+func LoadState(dir string) (*State, error) {
+	state := &State{Entries: map[string]*Entry{}}
+	data, err := os.ReadFile(filepath.Join(dir, StateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sync state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse sync state: %w", err)
+	}
+	if state.Entries == nil {
+		state.Entries = map[string]*Entry{}
+	}
+	return state, nil
+}
+
+// Save writes the state to dir
+func (s *State) Save(dir string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, StateFile), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write sync state: %w", err)
+	}
+	return nil
+}
+
+// Hash returns the hash of file content
+func Hash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// KindDir returns the directory of a content kind ("post" → "posts")
+func KindDir(kind string) string {
+	return kind + "s"
+}
+
+// LocalFile is a Markdown file in the sync directory
+type LocalFile struct {
+	Kind      string
+	Path      string // Relative to the sync directory (slash-separated)
+	ID        string // From front matter ("" for new files)
+	UpdatedAt string // From front matter
+	Title     string
+	Hash      string
+}
+
+// Scan lists the Markdown files in the posts and pages directories of dir
+func Scan(dir string) ([]LocalFile, error) {
+	var files []LocalFile
+	for _, kind := range Kinds {
+		matches, err := filepath.Glob(filepath.Join(dir, KindDir(kind), "*.md"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(matches)
+		for _, path := range matches {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			fm, _, err := input.ParseFrontMatter(string(data))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			file := LocalFile{
+				Kind: kind,
+				Path: KindDir(kind) + "/" + filepath.Base(path),
+				Hash: Hash(string(data)),
+			}
+			if fm != nil {
+				file.ID, file.UpdatedAt, file.Title = fm.ID, fm.UpdatedAt, fm.Title
+			}
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// Remote is a post or page on the site
+type Remote struct {
+	Kind      string
+	ID        string
+	Slug      string
+	Title     string
+	UpdatedAt string
+}
+
+// Status values of a change
+const (
+	StatusNew             = "new"              // Local file without ID (push creates it)
+	StatusModified        = "modified"         // Edited locally (push updates it)
+	StatusRemoteModified  = "remote-modified"  // Edited on the site (pull updates the file)
+	StatusConflict        = "conflict"         // Edited on both sides
+	StatusRemoteNew       = "remote-new"       // Not pulled yet (pull writes it)
+	StatusDeletedLocally  = "deleted-locally"  // File removed (the site is not changed)
+	StatusDeletedRemotely = "deleted-remotely" // Removed from the site (the file is kept)
+)
+
+// Change is a difference between the directory and the site
+type Change struct {
+	Status string `json:"status"`
+	Kind   string `json:"kind"`
+	ID     string `json:"id,omitempty"`
+	Path   string `json:"path,omitempty"`
+	Title  string `json:"title,omitempty"`
+}
+
+// Compare returns the changes between local files and the site, ordered by path
+func Compare(local []LocalFile, remote []Remote, state *State) []Change {
+	remoteByID := map[string]Remote{}
+	for _, r := range remote {
+		remoteByID[r.ID] = r
+	}
+
+	var changes []Change
+	seen := map[string]bool{}
+	for _, file := range local {
+		if file.ID == "" {
+			changes = append(changes, Change{StatusNew, file.Kind, "", file.Path, file.Title})
+			continue
+		}
+		seen[file.ID] = true
+
+		r, ok := remoteByID[file.ID]
+		if !ok {
+			changes = append(changes, Change{StatusDeletedRemotely, file.Kind, file.ID, file.Path, file.Title})
+			continue
+		}
+		entry := state.Entries[file.ID]
+		localChanged := entry == nil || entry.Hash != file.Hash
+		remoteChanged := r.UpdatedAt != file.UpdatedAt
+		switch {
+		case localChanged && remoteChanged:
+			changes = append(changes, Change{StatusConflict, file.Kind, file.ID, file.Path, file.Title})
+		case localChanged:
+			changes = append(changes, Change{StatusModified, file.Kind, file.ID, file.Path, file.Title})
+		case remoteChanged:
+			changes = append(changes, Change{StatusRemoteModified, file.Kind, file.ID, file.Path, r.Title})
+		}
+	}
+
+	for _, r := range remote {
+		if seen[r.ID] {
+			continue
+		}
+		if entry, ok := state.Entries[r.ID]; ok {
+			changes = append(changes, Change{StatusDeletedLocally, r.Kind, r.ID, entry.Path, r.Title})
+			continue
+		}
+		changes = append(changes, Change{StatusRemoteNew, r.Kind, r.ID, KindDir(r.Kind) + "/" + r.Slug + ".md", r.Title})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+// frontMatterKeyPattern matches a top-level "key:" line
+var frontMatterKeyPattern = regexp.MustCompile(`^([A-Za-z_][\w-]*)\s*:`)
+
+// SetFrontMatterKeys sets top-level scalar keys in the front matter of content
+//
+// Existing keys are replaced in place and missing keys are inserted at the
+// top, so the rest of the file is left as written (content without front
+// matter gets a new block).
+func SetFrontMatterKeys(content string, keys [][2]string) string {
+	block, body := input.SplitFrontMatter(content)
+	var lines []string
+	if len(block) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(block), "\n"), "\n")
+	}
+
+	var missing []string
+	for _, kv := range keys {
+		// Quoted, so that IDs and timestamps stay strings
+		line := fmt.Sprintf("%s: %q", kv[0], kv[1])
+		found := false
+		for i, existing := range lines {
+			if m := frontMatterKeyPattern.FindStringSubmatch(existing); m != nil && m[1] == kv[0] {
+				lines[i] = line
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, line)
+		}
+	}
+	lines = append(missing, lines...)
+
+	result := "---\n" + strings.Join(lines, "\n") + "\n---\n"
+	if body != "" {
+		result += "\n" + body
+	}
+	return result
+}
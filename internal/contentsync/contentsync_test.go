@@ -0,0 +1,147 @@
+/**
+ * contentsync_test.go
+ * Test code for sync state, directory scanning, and change detection
+ */
+
+package contentsync
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeFile writes a file below dir, creating directories
+func writeFile(t *testing.T, dir, path, content string) {
+	t.Helper()
+	full := filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestState_RoundTrip tests saving and loading the sync state
+func TestState_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	state, err := LoadState(dir)
+	if err != nil {
+		t.Fatalf("LoadState() error: %v", err)
+	}
+	if state.Site != "" || len(state.Entries) != 0 {
+		t.Fatalf("LoadState() of a new directory = %+v", state)
+	}
+
+	state.Site = "https://example.com"
+	state.Entries["1"] = &Entry{Kind: "post", Path: "posts/a.md", UpdatedAt: "2024-01-01T00:00:00Z", Hash: Hash("a")}
+	if err := state.Save(dir); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	loaded, err := LoadState(dir)
+	if err != nil {
+		t.Fatalf("LoadState() error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, state) {
+		t.Errorf("LoadState() = %+v; want %+v", loaded, state)
+	}
+}
+
+// TestScan tests reading IDs and timestamps from front matter
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "posts/a.md", "---\nid: \"1\"\nupdated_at: \"2024-01-01T00:00:00Z\"\ntitle: A\n---\n\nBody\n")
+	writeFile(t, dir, "pages/new.md", "# No front matter\n")
+	writeFile(t, dir, "posts/notes.txt", "ignored")
+
+	files, err := Scan(dir)
+	if err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("Scan() = %+v; want 2 files", files)
+	}
+	if f := files[0]; f.Kind != "post" || f.Path != "posts/a.md" || f.ID != "1" || f.UpdatedAt != "2024-01-01T00:00:00Z" || f.Title != "A" {
+		t.Errorf("files[0] = %+v", f)
+	}
+	if f := files[1]; f.Kind != "page" || f.Path != "pages/new.md" || f.ID != "" {
+		t.Errorf("files[1] = %+v", f)
+	}
+}
+
+// TestCompare tests change detection on both sides
+func TestCompare(t *testing.T) {
+	const t1, t2 = "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z"
+	state := &State{Entries: map[string]*Entry{
+		"same":     {Path: "posts/same.md", Hash: "h-same"},
+		"local":    {Path: "posts/local.md", Hash: "h-old"},
+		"remote":   {Path: "posts/remote.md", Hash: "h-remote"},
+		"both":     {Path: "posts/both.md", Hash: "h-old"},
+		"removed":  {Path: "posts/removed.md", Hash: "h-removed"},
+		"gone":     {Path: "posts/gone.md", Hash: "h-gone"},
+		"untraced": {Path: "pages/x.md", Hash: "h-x"},
+	}}
+	local := []LocalFile{
+		{Kind: "post", Path: "posts/same.md", ID: "same", UpdatedAt: t1, Hash: "h-same"},
+		{Kind: "post", Path: "posts/local.md", ID: "local", UpdatedAt: t1, Hash: "h-new"},
+		{Kind: "post", Path: "posts/remote.md", ID: "remote", UpdatedAt: t1, Hash: "h-remote"},
+		{Kind: "post", Path: "posts/both.md", ID: "both", UpdatedAt: t1, Hash: "h-new"},
+		{Kind: "post", Path: "posts/gone.md", ID: "gone", UpdatedAt: t1, Hash: "h-gone"},
+		{Kind: "post", Path: "posts/draft.md", Title: "Draft"},
+	}
+	remote := []Remote{
+		{Kind: "post", ID: "same", UpdatedAt: t1},
+		{Kind: "post", ID: "local", UpdatedAt: t1},
+		{Kind: "post", ID: "remote", UpdatedAt: t2, Title: "Remote"},
+		{Kind: "post", ID: "both", UpdatedAt: t2},
+		{Kind: "post", ID: "removed", UpdatedAt: t1},
+		{Kind: "page", ID: "fresh", Slug: "about", UpdatedAt: t1, Title: "About"},
+	}
+
+	got := Compare(local, remote, state)
+	want := []Change{
+		{StatusRemoteNew, "page", "fresh", "pages/about.md", "About"},
+		{StatusConflict, "post", "both", "posts/both.md", ""},
+		{StatusNew, "post", "", "posts/draft.md", "Draft"},
+		{StatusDeletedRemotely, "post", "gone", "posts/gone.md", ""},
+		{StatusModified, "post", "local", "posts/local.md", ""},
+		{StatusRemoteModified, "post", "remote", "posts/remote.md", "Remote"},
+		{StatusDeletedLocally, "post", "removed", "posts/removed.md", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+// TestSetFrontMatterKeys tests replacing and inserting front matter keys
+func TestSetFrontMatterKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "replaces existing and inserts missing keys",
+			content: "---\ntitle: Hello\nupdated_at: old\ntags:\n  - go\n---\n\nBody\n",
+			want:    "---\nid: \"1\"\ntitle: Hello\nupdated_at: \"2024-01-01T00:00:00Z\"\ntags:\n  - go\n---\n\nBody\n",
+		},
+		{
+			name:    "adds a block to content without front matter",
+			content: "# Hello\n",
+			want:    "---\nid: \"1\"\nupdated_at: \"2024-01-01T00:00:00Z\"\n---\n\n# Hello\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SetFrontMatterKeys(tt.content, [][2]string{{"id", "1"}, {"updated_at", "2024-01-01T00:00:00Z"}})
+			if got != tt.want {
+				t.Errorf("SetFrontMatterKeys() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/**
+ * filetype.go
+ * Upload file types
+ *
+ * Mirrors the file extensions and content types Ghost accepts for each
+ * upload endpoint, so that unsupported files are rejected locally before
+ * anything is sent.
+ */
+
+package filetype
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Kind is a category of upload with its allowed extensions
+type Kind struct {
+	Name       string
+	Extensions map[string]string // Extension (with dot, lowercase) -> content type
+}
+
+// Image is an image upload (also used for media thumbnails)
+var Image = Kind{
+	Name: "image",
+	Extensions: map[string]string{
+		".jpg":  "image/jpeg",
+		".jpeg": "image/jpeg",
+		".png":  "image/png",
+		".gif":  "image/gif",
+		".webp": "image/webp",
+		".svg":  "image/svg+xml",
+		".svgz": "image/svg+xml",
+		".ico":  "image/x-icon",
+	},
+}
+
+// Media is a video or audio upload
+var Media = Kind{
+	Name: "media",
+	Extensions: map[string]string{
+		".mp4":  "video/mp4",
+		".webm": "video/webm",
+		".ogv":  "video/ogg",
+		".mp3":  "audio/mpeg",
+		".wav":  "audio/wav",
+		".ogg":  "audio/ogg",
+		".m4a":  "audio/mp4",
+	},
+}
+
+// File is an attachment upload (file cards)
+var File = Kind{
+	Name: "file",
+	Extensions: map[string]string{
+		".pdf":    "application/pdf",
+		".json":   "application/json",
+		".jsonld": "application/ld+json",
+		".odp":    "application/vnd.oasis.opendocument.presentation",
+		".ods":    "application/vnd.oasis.opendocument.spreadsheet",
+		".odt":    "application/vnd.oasis.opendocument.text",
+		".ppt":    "application/vnd.ms-powerpoint",
+		".pptx":   "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		".rtf":    "application/rtf",
+		".txt":    "text/plain",
+		".xls":    "application/vnd.ms-excel",
+		".xlsx":   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		".xml":    "application/xml",
+		".doc":    "application/msword",
+		".docx":   "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		".csv":    "text/csv",
+		".md":     "text/markdown",
+		".epub":   "application/epub+zip",
+		".zip":    "application/zip",
+	},
+}
+
+// ContentType returns the content type for a file name, checking it is allowed
+//
+// The extension decides the type; head (the start of the file) is sniffed
+// to catch files whose content does not match their extension.
+func (k Kind) ContentType(filename string, head []byte) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+	contentType, ok := k.Extensions[ext]
+	if !ok {
+		if ext == "" {
+			return "", fmt.Errorf("%s has no extension; allowed %s types: %s", filename, k.Name, strings.Join(k.AllowedExtensions(), ", "))
+		}
+		return "", fmt.Errorf("unsupported %s type %s (allowed: %s)", k.Name, ext, strings.Join(k.AllowedExtensions(), ", "))
+	}
+
+	// Sniffed types that clearly belong to another category are rejected
+	if len(head) > 0 {
+		sniffed := http.DetectContentType(head)
+		major := strings.SplitN(contentType, "/", 2)[0]
+		sniffedMajor := strings.SplitN(sniffed, "/", 2)[0]
+		if isSpecific(sniffed) && (major == "video" || major == "audio" || major == "image") && sniffedMajor != major && !compatible(contentType, sniffed) {
+			return "", fmt.Errorf("%s looks like %s, not %s", filename, sniffed, contentType)
+		}
+	}
+
+	return contentType, nil
+}
+
+// AllowedExtensions returns the allowed extensions, sorted
+func (k Kind) AllowedExtensions() []string {
+	exts := make([]string, 0, len(k.Extensions))
+	for ext := range k.Extensions {
+		exts = append(exts, ext)
+	}
+	sort.Strings(exts)
+	return exts
+}
+
+// isSpecific reports whether a sniffed type says something about the content
+func isSpecific(contentType string) bool {
+	return !strings.HasPrefix(contentType, "application/octet-stream") && !strings.HasPrefix(contentType, "text/plain")
+}
+
+// compatible reports whether a sniffed type is an accepted variant of the expected one
+//
+// Ogg and WebM containers hold both audio and video, and SVG is sniffed as XML.
+func compatible(expected, sniffed string) bool {
+	sniffed = strings.SplitN(sniffed, ";", 2)[0]
+	switch sniffed {
+	case "application/ogg", "video/webm", "audio/webm":
+		return strings.Contains(expected, "ogg") || strings.Contains(expected, "webm")
+	case "text/xml", "text/html":
+		return expected == "image/svg+xml"
+	case "video/mp4", "audio/mp4":
+		return strings.HasSuffix(expected, "/mp4")
+	}
+	return false
+}
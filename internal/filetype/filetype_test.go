@@ -0,0 +1,51 @@
+/**
+ * filetype_test.go
+ * Test code for upload file types
+ */
+
+package filetype
+
+import (
+	"strings"
+	"testing"
+)
+
+// pngHeader is the signature of a PNG file
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// TestContentType tests allowed and rejected files
+func TestContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     Kind
+		filename string
+		head     []byte
+		want     string
+		wantErr  string
+	}{
+		{"video by extension", Media, "clip.MP4", nil, "video/mp4", ""},
+		{"audio with ID3 header", Media, "episode.mp3", []byte("ID3\x03\x00\x00\x00"), "audio/mpeg", ""},
+		{"ogg container", Media, "song.ogg", []byte("OggS\x00"), "audio/ogg", ""},
+		{"unsupported media", Media, "movie.mkv", nil, "", "unsupported media type .mkv"},
+		{"image renamed to video", Media, "clip.mp4", pngHeader, "", "looks like image/png"},
+		{"pdf attachment", File, "report.pdf", []byte("%PDF-1.7"), "application/pdf", ""},
+		{"executable attachment", File, "setup.exe", nil, "", "unsupported file type .exe"},
+		{"no extension", File, "README", nil, "", "has no extension"},
+		{"thumbnail", Image, "poster.jpg", nil, "image/jpeg", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.kind.ContentType(tt.filename, tt.head)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ContentType() error = %v; want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ContentType() = %q, %v; want %q", got, err, tt.want)
+			}
+		})
+	}
+}
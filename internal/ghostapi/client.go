@@ -16,11 +16,15 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	neturl "net/url"
 	"strings"
 	"time"
 )
 
+// quoteEscaper escapes quotes in multipart header values (as mime/multipart does)
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
 // Client is the Ghost Admin API client
 type Client struct {
 	baseURL    string
@@ -70,6 +74,11 @@ func NewClient(baseURL, keyID, secret string) (*Client, error) {
 	}, nil
 }
 
+// BaseURL returns the site URL the client sends requests to
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
 // doRequest executes an HTTP request and returns the response body.
 func (c *Client) doRequest(method, path string, body io.Reader) ([]byte, error) {
 	return c.doRequestWithOptions(method, path, body, nil)
@@ -152,6 +161,25 @@ func (c *Client) doRequestWithOptions(method, path string, body io.Reader, opts
 
 // doMultipartRequest executes an HTTP request with multipart/form-data and returns the response body.
 func (c *Client) doMultipartRequest(path string, file io.Reader, filename string, fields map[string]string) ([]byte, error) {
+	return c.doMultipartRequestWithField(path, "file", file, filename, fields)
+}
+
+// doMultipartRequestWithField executes a multipart request with the file in the given form field
+// (some endpoints expect a field other than "file", e.g. "redirects" or "routes").
+func (c *Client) doMultipartRequestWithField(path, fileField string, file io.Reader, filename string, fields map[string]string) ([]byte, error) {
+	return c.doMultipartRequestWithFiles(path, []multipartFile{{Field: fileField, Reader: file, Filename: filename}}, fields)
+}
+
+// multipartFile is a file part of a multipart request
+type multipartFile struct {
+	Field       string    // Form field name
+	Reader      io.Reader // File content
+	Filename    string    // File name sent to the server
+	ContentType string    // Part content type (default: application/octet-stream)
+}
+
+// doMultipartRequestWithFiles executes a multipart request with one or more files
+func (c *Client) doMultipartRequestWithFiles(path string, files []multipartFile, fields map[string]string) ([]byte, error) {
 	// Generate JWT token
 	token, err := GenerateJWT(c.keyID, c.secret)
 	if err != nil {
@@ -162,13 +190,23 @@ func (c *Client) doMultipartRequest(path string, file io.Reader, filename string
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
-	// Add file field
-	part, err := writer.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create file field: %w", err)
-	}
-	if _, err := io.Copy(part, file); err != nil {
-		return nil, fmt.Errorf("failed to copy file: %w", err)
+	// Add file fields
+	for _, file := range files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(file.Field), quoteEscaper.Replace(file.Filename)))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create file field: %w", err)
+		}
+		if _, err := io.Copy(part, file.Reader); err != nil {
+			return nil, fmt.Errorf("failed to copy file: %w", err)
+		}
 	}
 
 	// Add additional fields
@@ -0,0 +1,56 @@
+/**
+ * db.go
+ * DB (content export/import) API
+ *
+ * Provides full content export and import through the Ghost Admin API db endpoint.
+ * Importing adds the exported content to the site.
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ImportProblem represents a problem reported while importing content
+type ImportProblem struct {
+	Message string          `json:"message"`
+	Help    string          `json:"help,omitempty"`
+	Context string          `json:"context,omitempty"`
+	Err     json.RawMessage `json:"err,omitempty"`
+}
+
+// ExportContent downloads the full JSON content export of the site
+func (c *Client) ExportContent() ([]byte, error) {
+	path := "/ghost/api/admin/db/"
+
+	// Execute request
+	return c.doRequest("GET", path, nil)
+}
+
+// ImportContent imports a JSON content export
+//
+// Returns the problems Ghost reported (the import can succeed with problems).
+func (c *Client) ImportContent(file io.Reader, filename string) ([]ImportProblem, error) {
+	path := "/ghost/api/admin/db/"
+
+	// Execute multipart request
+	respBody, err := c.doMultipartRequestWithField(path, "importfile", file, filename, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp struct {
+		Problems []ImportProblem `json:"problems"`
+	}
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+
+	return resp.Problems, nil
+}
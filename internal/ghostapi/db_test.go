@@ -0,0 +1,81 @@
+/**
+ * db_test.go
+ * Test code for DB (content export/import) API
+ */
+
+package ghostapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestExportContent_ReturnsRawExport tests downloading the content export
+func TestExportContent_ReturnsRawExport(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/db/" || r.Method != "GET" {
+			t.Errorf("request = %s %s; want GET /ghost/api/admin/db/", r.Method, r.URL.Path)
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"db":[{"meta":{"version":"5.0.0"},"data":{"posts":[]}}]}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	data, err := client.ExportContent()
+	if err != nil {
+		t.Fatalf("export error: %v", err)
+	}
+	if !strings.Contains(string(data), `"version":"5.0.0"`) {
+		t.Errorf("data = %s", data)
+	}
+}
+
+// TestImportContent_ReturnsProblems tests importing content with the importfile field
+func TestImportContent_ReturnsProblems(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.Method != "POST" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "POST")
+		}
+		file, header, err := r.FormFile("importfile")
+		if err != nil {
+			t.Fatalf("form file error: %v", err)
+		}
+		file.Close()
+		if header.Filename != "backup.json" {
+			t.Errorf("filename = %q; want %q", header.Filename, "backup.json")
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"db":[],"problems":[{"message":"Entry was not imported and ignored. Detected duplicated entry.","help":"Tag"}]}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	problems, err := client.ImportContent(strings.NewReader(`{"db":[]}`), "backup.json")
+	if err != nil {
+		t.Fatalf("import error: %v", err)
+	}
+	if len(problems) != 1 || problems[0].Help != "Tag" {
+		t.Errorf("problems = %+v; want one Tag problem", problems)
+	}
+}
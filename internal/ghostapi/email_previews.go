@@ -0,0 +1,88 @@
+/**
+ * email_previews.go
+ * Email Previews API
+ *
+ * Provides email preview and test email functionality for posts in the Ghost Admin API.
+ */
+
+package ghostapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// EmailPreview represents the rendered email version of a post
+type EmailPreview struct {
+	Subject   string `json:"subject"`
+	HTML      string `json:"html"`
+	Plaintext string `json:"plaintext,omitempty"`
+}
+
+// EmailPreviewOptions represents options for rendering an email preview
+type EmailPreviewOptions struct {
+	Newsletter    string // Newsletter slug (default newsletter if empty)
+	MemberSegment string // Member segment filter (e.g., status:free, status:-free)
+}
+
+// EmailPreviewResponse represents an email preview response
+type EmailPreviewResponse struct {
+	EmailPreviews []EmailPreview `json:"email_previews"`
+}
+
+// GetPostEmailPreview retrieves the rendered email HTML and subject of a post
+func (c *Client) GetPostEmailPreview(postID string, opts EmailPreviewOptions) (*EmailPreview, error) {
+	path := fmt.Sprintf("/ghost/api/admin/email_previews/posts/%s/", postID)
+
+	// Build request options
+	reqOpts := &RequestOptions{
+		QueryParams: map[string]string{
+			"newsletter":    opts.Newsletter,
+			"memberSegment": opts.MemberSegment,
+		},
+	}
+
+	// Execute request
+	respBody, err := c.doRequestWithOptions("GET", path, nil, reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp EmailPreviewResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.EmailPreviews) == 0 {
+		return nil, fmt.Errorf("email preview not available for post: %s", postID)
+	}
+
+	return &resp.EmailPreviews[0], nil
+}
+
+// SendTestEmail sends the email version of a post to the given addresses
+func (c *Client) SendTestEmail(postID string, emails []string, opts EmailPreviewOptions) error {
+	path := fmt.Sprintf("/ghost/api/admin/email_previews/posts/%s/", postID)
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"emails": emails,
+	}
+	if opts.Newsletter != "" {
+		reqBody["newsletter"] = opts.Newsletter
+	}
+	if opts.MemberSegment != "" {
+		reqBody["memberSegment"] = opts.MemberSegment
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	// Execute request
+	_, err = c.doRequest("POST", path, bytes.NewReader(bodyBytes))
+	return err
+}
@@ -0,0 +1,112 @@
+/**
+ * email_previews_test.go
+ * Test code for Email Previews API
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetPostEmailPreview_WithNewsletterAndSegment retrieves an email preview for a segment
+func TestGetPostEmailPreview_WithNewsletterAndSegment(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/email_previews/posts/post123/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/email_previews/posts/post123/")
+		}
+		if r.Method != "GET" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "GET")
+		}
+		if r.URL.Query().Get("newsletter") != "weekly" {
+			t.Errorf("newsletter = %q; want %q", r.URL.Query().Get("newsletter"), "weekly")
+		}
+		if r.URL.Query().Get("memberSegment") != "status:free" {
+			t.Errorf("memberSegment = %q; want %q", r.URL.Query().Get("memberSegment"), "status:free")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"email_previews": []map[string]interface{}{
+				{
+					"subject":   "Hello subscribers",
+					"html":      "<html><body>Hello</body></html>",
+					"plaintext": "Hello",
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	preview, err := client.GetPostEmailPreview("post123", EmailPreviewOptions{
+		Newsletter:    "weekly",
+		MemberSegment: "status:free",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get email preview: %v", err)
+	}
+
+	// Verify response
+	if preview.Subject != "Hello subscribers" {
+		t.Errorf("Subject = %q; want %q", preview.Subject, "Hello subscribers")
+	}
+	if preview.HTML != "<html><body>Hello</body></html>" {
+		t.Errorf("HTML = %q; want %q", preview.HTML, "<html><body>Hello</body></html>")
+	}
+}
+
+// TestSendTestEmail_SendsRecipients sends a test email to multiple addresses
+func TestSendTestEmail_SendsRecipients(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/email_previews/posts/post123/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/email_previews/posts/post123/")
+		}
+		if r.Method != "POST" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "POST")
+		}
+
+		// Verify request body
+		var reqBody struct {
+			Emails     []string `json:"emails"`
+			Newsletter string   `json:"newsletter"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("request body parse error: %v", err)
+		}
+		if len(reqBody.Emails) != 2 || reqBody.Emails[1] != "c@d.example" {
+			t.Errorf("emails = %v; want [a@b.example c@d.example]", reqBody.Emails)
+		}
+		if reqBody.Newsletter != "weekly" {
+			t.Errorf("newsletter = %q; want %q", reqBody.Newsletter, "weekly")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.SendTestEmail("post123", []string{"a@b.example", "c@d.example"}, EmailPreviewOptions{Newsletter: "weekly"})
+	if err != nil {
+		t.Fatalf("Failed to send test email: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+/**
+ * emails.go
+ * Emails API
+ *
+ * Provides newsletter email delivery information for the Ghost Admin API.
+ * An email is created when a post is sent as a newsletter.
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Email represents a newsletter email sent for a post
+type Email struct {
+	ID              string     `json:"id"`
+	PostID          string     `json:"post_id,omitempty"`
+	NewsletterID    string     `json:"newsletter_id,omitempty"`
+	Status          string     `json:"status,omitempty"` // pending, submitting, submitted, failed
+	Subject         string     `json:"subject,omitempty"`
+	RecipientFilter string     `json:"recipient_filter,omitempty"`
+	Error           string     `json:"error,omitempty"`
+	EmailCount      int        `json:"email_count"`
+	DeliveredCount  int        `json:"delivered_count"`
+	OpenedCount     int        `json:"opened_count"`
+	FailedCount     int        `json:"failed_count"`
+	TrackOpens      bool       `json:"track_opens,omitempty"`
+	TrackClicks     bool       `json:"track_clicks,omitempty"`
+	SubmittedAt     *time.Time `json:"submitted_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// EmailListOptions represents options for retrieving email list
+type EmailListOptions struct {
+	Limit  int    // Number of items to retrieve (default: 15)
+	Page   int    // Page number (default: 1)
+	Filter string // Filter condition (e.g., status:failed)
+}
+
+// EmailListResponse represents an email list response
+type EmailListResponse struct {
+	Emails []Email `json:"emails"`
+	Meta   struct {
+		Pagination struct {
+			Page  int `json:"page"`
+			Limit int `json:"limit"`
+			Pages int `json:"pages"`
+			Total int `json:"total"`
+		} `json:"pagination"`
+	} `json:"meta"`
+}
+
+// EmailResponse represents a single email response
+type EmailResponse struct {
+	Emails []Email `json:"emails"`
+}
+
+// PostLink represents a tracked link in a post email with its click count
+type PostLink struct {
+	PostID string `json:"post_id"`
+	Link   struct {
+		LinkID string `json:"link_id"`
+		From   string `json:"from"`
+		To     string `json:"to"`
+	} `json:"link"`
+	Count struct {
+		Clicks int `json:"clicks"`
+	} `json:"count"`
+}
+
+// EmailStats represents delivery analytics for a post sent as a newsletter
+type EmailStats struct {
+	PostID  string     `json:"post_id"`
+	Email   *Email     `json:"email"`
+	Clicked int        `json:"clicked"` // Number of members who clicked any link
+	Links   []PostLink `json:"links"`
+}
+
+// ListEmails retrieves recent newsletter emails (newest first)
+func (c *Client) ListEmails(opts EmailListOptions) (*EmailListResponse, error) {
+	path := "/ghost/api/admin/emails/"
+
+	// Build request options
+	reqOpts := &RequestOptions{
+		QueryParams: map[string]string{
+			"filter": opts.Filter,
+			"order":  "created_at desc",
+		},
+	}
+	if opts.Limit > 0 {
+		reqOpts.QueryParams["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.Page > 0 {
+		reqOpts.QueryParams["page"] = strconv.Itoa(opts.Page)
+	}
+
+	// Execute request
+	respBody, err := c.doRequestWithOptions("GET", path, nil, reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp EmailListResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// GetEmail retrieves a newsletter email by ID
+func (c *Client) GetEmail(id string) (*Email, error) {
+	path := fmt.Sprintf("/ghost/api/admin/emails/%s/", id)
+
+	// Execute request
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseEmailResponse(respBody, id)
+}
+
+// RetryEmail retries sending the failed batches of a newsletter email
+func (c *Client) RetryEmail(id string) (*Email, error) {
+	path := fmt.Sprintf("/ghost/api/admin/emails/%s/retry/", id)
+
+	// Execute request
+	respBody, err := c.doRequest("PUT", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseEmailResponse(respBody, id)
+}
+
+// ListPostLinks retrieves the tracked links of a post with click counts
+func (c *Client) ListPostLinks(postID string) ([]PostLink, error) {
+	path := "/ghost/api/admin/links/"
+
+	// Build request options
+	reqOpts := &RequestOptions{
+		QueryParams: map[string]string{
+			"filter": fmt.Sprintf("post_id:'%s'", postID),
+		},
+	}
+
+	// Execute request
+	respBody, err := c.doRequestWithOptions("GET", path, nil, reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp struct {
+		Links []PostLink `json:"links"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return resp.Links, nil
+}
+
+// GetPostEmailStats retrieves delivery analytics of a post sent as a newsletter
+//
+// Uses the post's email relation and click count, plus per-link click counts.
+func (c *Client) GetPostEmailStats(postID string) (*EmailStats, error) {
+	path := fmt.Sprintf("/ghost/api/admin/posts/%s/", postID)
+
+	// Build request options
+	reqOpts := &RequestOptions{
+		QueryParams: map[string]string{
+			"include": "email,count.clicks",
+		},
+	}
+
+	// Execute request
+	respBody, err := c.doRequestWithOptions("GET", path, nil, reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp struct {
+		Posts []Post `json:"posts"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(resp.Posts) == 0 {
+		return nil, fmt.Errorf("post not found: %s", postID)
+	}
+
+	post := resp.Posts[0]
+	if post.Email == nil {
+		return nil, fmt.Errorf("post has not been sent as an email: %s", postID)
+	}
+
+	stats := &EmailStats{
+		PostID: post.ID,
+		Email:  post.Email,
+	}
+	if post.Count != nil {
+		stats.Clicked = post.Count.Clicks
+	}
+
+	// Get link click counts
+	links, err := c.ListPostLinks(post.ID)
+	if err != nil {
+		return nil, err
+	}
+	stats.Links = links
+
+	return stats, nil
+}
+
+// parseEmailResponse parses a single email response
+func parseEmailResponse(respBody []byte, id string) (*Email, error) {
+	var resp EmailResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Emails) == 0 {
+		return nil, fmt.Errorf("email not found: %s", id)
+	}
+
+	return &resp.Emails[0], nil
+}
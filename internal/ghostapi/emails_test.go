@@ -0,0 +1,206 @@
+/**
+ * emails_test.go
+ * Test code for Emails API
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestListEmails_NewestFirst retrieves recent emails with a filter
+func TestListEmails_NewestFirst(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/emails/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/emails/")
+		}
+		if r.URL.Query().Get("order") != "created_at desc" {
+			t.Errorf("order = %q; want %q", r.URL.Query().Get("order"), "created_at desc")
+		}
+		if r.URL.Query().Get("filter") != "status:failed" {
+			t.Errorf("filter = %q; want %q", r.URL.Query().Get("filter"), "status:failed")
+		}
+		if r.URL.Query().Get("limit") != "5" {
+			t.Errorf("limit = %q; want %q", r.URL.Query().Get("limit"), "5")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"emails": []map[string]interface{}{
+				{
+					"id":              "email1",
+					"post_id":         "post1",
+					"status":          "failed",
+					"subject":         "Weekly #1",
+					"email_count":     100,
+					"delivered_count": 80,
+					"failed_count":    20,
+					"created_at":      "2026-01-01T00:00:00.000Z",
+					"updated_at":      "2026-01-01T00:00:00.000Z",
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.ListEmails(EmailListOptions{Limit: 5, Filter: "status:failed"})
+	if err != nil {
+		t.Fatalf("Failed to list emails: %v", err)
+	}
+
+	// Verify response
+	if len(resp.Emails) != 1 {
+		t.Fatalf("number of emails = %d; want 1", len(resp.Emails))
+	}
+	if resp.Emails[0].FailedCount != 20 {
+		t.Errorf("FailedCount = %d; want 20", resp.Emails[0].FailedCount)
+	}
+}
+
+// TestRetryEmail_UsesRetryEndpoint retries a failed email
+func TestRetryEmail_UsesRetryEndpoint(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/emails/email1/retry/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/emails/email1/retry/")
+		}
+		if r.Method != "PUT" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "PUT")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"emails": []map[string]interface{}{
+				{"id": "email1", "status": "pending"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	email, err := client.RetryEmail("email1")
+	if err != nil {
+		t.Fatalf("Failed to retry email: %v", err)
+	}
+	if email.Status != "pending" {
+		t.Errorf("Status = %q; want %q", email.Status, "pending")
+	}
+}
+
+// TestGetPostEmailStats_CombinesEmailAndLinks retrieves email analytics of a post
+func TestGetPostEmailStats_CombinesEmailAndLinks(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/ghost/api/admin/posts/post1/":
+			// Verify the email relation and click count are requested
+			if r.URL.Query().Get("include") != "email,count.clicks" {
+				t.Errorf("include = %q; want %q", r.URL.Query().Get("include"), "email,count.clicks")
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"posts": []map[string]interface{}{
+					{
+						"id":     "post1",
+						"title":  "Weekly #1",
+						"status": "published",
+						"email": map[string]interface{}{
+							"id":              "email1",
+							"status":          "submitted",
+							"email_count":     100,
+							"delivered_count": 98,
+							"opened_count":    60,
+							"failed_count":    2,
+						},
+						"count": map[string]interface{}{"clicks": 15},
+					},
+				},
+			})
+		case "/ghost/api/admin/links/":
+			if r.URL.Query().Get("filter") != "post_id:'post1'" {
+				t.Errorf("filter = %q; want %q", r.URL.Query().Get("filter"), "post_id:'post1'")
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"links": []map[string]interface{}{
+					{
+						"post_id": "post1",
+						"link":    map[string]interface{}{"link_id": "l1", "from": "", "to": "https://example.com/"},
+						"count":   map[string]interface{}{"clicks": 12},
+					},
+				},
+			})
+		default:
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	stats, err := client.GetPostEmailStats("post1")
+	if err != nil {
+		t.Fatalf("Failed to get email stats: %v", err)
+	}
+
+	// Verify response
+	if stats.Email.DeliveredCount != 98 || stats.Email.OpenedCount != 60 || stats.Email.FailedCount != 2 {
+		t.Errorf("Email = %+v; want delivered=98 opened=60 failed=2", stats.Email)
+	}
+	if stats.Clicked != 15 {
+		t.Errorf("Clicked = %d; want 15", stats.Clicked)
+	}
+	if len(stats.Links) != 1 || stats.Links[0].Count.Clicks != 12 {
+		t.Errorf("Links = %+v; want one link with 12 clicks", stats.Links)
+	}
+}
+
+// TestGetPostEmailStats_NotSent returns an error for posts without an email
+func TestGetPostEmailStats_NotSent(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"posts": []map[string]interface{}{
+				{"id": "post1", "title": "Draft", "status": "draft", "email": nil},
+			},
+		})
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.GetPostEmailStats("post1"); err == nil {
+		t.Error("expected error for post that was not sent as an email")
+	}
+}
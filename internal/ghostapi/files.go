@@ -0,0 +1,61 @@
+/**
+ * files.go
+ * Files API
+ *
+ * Provides attachment uploads (PDFs, documents, etc.) for the Ghost Admin API.
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// File represents an uploaded file
+type File struct {
+	URL string `json:"url"`
+	Ref string `json:"ref,omitempty"`
+}
+
+// FileUploadOptions represents options for file upload
+type FileUploadOptions struct {
+	ContentType string // Content type of the file
+	Ref         string // Reference ID for the file
+}
+
+// FileResponse represents a file response
+type FileResponse struct {
+	Files []File `json:"files"`
+}
+
+// UploadFile uploads a file
+func (c *Client) UploadFile(file io.Reader, filename string, opts FileUploadOptions) (*File, error) {
+	path := "/ghost/api/admin/files/upload/"
+
+	// Build multipart fields
+	fields := make(map[string]string)
+	if opts.Ref != "" {
+		fields["ref"] = opts.Ref
+	}
+
+	// Execute request
+	files := []multipartFile{{Field: "file", Reader: file, Filename: filename, ContentType: opts.ContentType}}
+	respBody, err := c.doMultipartRequestWithFiles(path, files, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp FileResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Files) == 0 {
+		return nil, fmt.Errorf("failed to upload file")
+	}
+
+	return &resp.Files[0], nil
+}
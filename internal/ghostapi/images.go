@@ -21,8 +21,9 @@ type Image struct {
 
 // ImageUploadOptions represents options for image upload
 type ImageUploadOptions struct {
-	Purpose string // image, profile_image, icon
-	Ref     string // Reference ID for the image
+	Purpose     string // image, profile_image, icon
+	Ref         string // Reference ID for the image
+	ContentType string // Content type of the image (default: application/octet-stream)
 }
 
 // ImageResponse represents an image response
@@ -44,7 +45,8 @@ func (c *Client) UploadImage(file io.Reader, filename string, opts ImageUploadOp
 	}
 
 	// Execute request
-	respBody, err := c.doMultipartRequest(path, file, filename, fields)
+	files := []multipartFile{{Field: "file", Reader: file, Filename: filename, ContentType: opts.ContentType}}
+	respBody, err := c.doMultipartRequestWithFiles(path, files, fields)
 	if err != nil {
 		return nil, err
 	}
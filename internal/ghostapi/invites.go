@@ -0,0 +1,126 @@
+/**
+ * invites.go
+ * Invites and Roles API
+ *
+ * Provides staff invitation and role functionality for the Ghost Admin API.
+ */
+
+package ghostapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Invite represents a pending staff user invitation
+type Invite struct {
+	ID        string    `json:"id,omitempty"`
+	Email     string    `json:"email"`
+	RoleID    string    `json:"role_id"`
+	Status    string    `json:"status,omitempty"`  // pending, sent
+	Expires   int64     `json:"expires,omitempty"` // Unix time in milliseconds
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// ExpiresAt returns the expiry time of the invitation
+func (i Invite) ExpiresAt() time.Time {
+	return time.UnixMilli(i.Expires)
+}
+
+// InviteListResponse represents an invite list response
+type InviteListResponse struct {
+	Invites []Invite `json:"invites"`
+}
+
+// RoleListResponse represents a role list response
+type RoleListResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+// ListRoles retrieves staff roles
+//
+// If assignable is true, only roles the current integration may assign are returned.
+func (c *Client) ListRoles(assignable bool) ([]Role, error) {
+	path := "/ghost/api/admin/roles/"
+	if assignable {
+		path += "?permissions=assign"
+	}
+
+	// Execute request
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp RoleListResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return resp.Roles, nil
+}
+
+// ListInvites retrieves pending staff invitations
+func (c *Client) ListInvites() ([]Invite, error) {
+	path := "/ghost/api/admin/invites/?limit=all"
+
+	// Execute request
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp InviteListResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return resp.Invites, nil
+}
+
+// CreateInvite invites a new staff user with the given role
+func (c *Client) CreateInvite(email, roleID string) (*Invite, error) {
+	path := "/ghost/api/admin/invites/"
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"invites": []Invite{{Email: email, RoleID: roleID}},
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	// Execute request
+	respBody, err := c.doRequest("POST", path, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp InviteListResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Invites) == 0 {
+		return nil, fmt.Errorf("failed to create invite")
+	}
+
+	return &resp.Invites[0], nil
+}
+
+// DeleteInvite revokes a staff invitation
+func (c *Client) DeleteInvite(id string) error {
+	path := fmt.Sprintf("/ghost/api/admin/invites/%s/", id)
+
+	// Execute request
+	_, err := c.doRequest("DELETE", path, nil)
+	return err
+}
@@ -0,0 +1,101 @@
+/**
+ * invites_test.go
+ * Test code for Invites and Roles API
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestListRoles_Assignable tests fetching assignable roles
+func TestListRoles_Assignable(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/roles/" {
+			t.Errorf("request path = %q; want %q", r.URL.Path, "/ghost/api/admin/roles/")
+		}
+		if r.URL.Query().Get("permissions") != "assign" {
+			t.Errorf("permissions = %q; want %q", r.URL.Query().Get("permissions"), "assign")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"roles": []map[string]interface{}{
+				{"id": "role1", "name": "Administrator", "description": "Administrators"},
+				{"id": "role2", "name": "Editor", "description": "Editors"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	roles, err := client.ListRoles(true)
+	if err != nil {
+		t.Fatalf("role list retrieval error: %v", err)
+	}
+	if len(roles) != 2 || roles[1].Name != "Editor" {
+		t.Errorf("roles = %+v; want Administrator and Editor", roles)
+	}
+}
+
+// TestCreateInvite_SendsEmailAndRole tests creating a staff invitation
+func TestCreateInvite_SendsEmailAndRole(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.Method != "POST" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "POST")
+		}
+
+		// Validate request body
+		var reqBody struct {
+			Invites []Invite `json:"invites"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("request body parse error: %v", err)
+		}
+		if reqBody.Invites[0].Email != "new@example.com" || reqBody.Invites[0].RoleID != "role2" {
+			t.Errorf("invite = %+v; want new@example.com with role2", reqBody.Invites[0])
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"invites": []map[string]interface{}{
+				{"id": "inv1", "email": "new@example.com", "role_id": "role2", "status": "sent", "expires": 1767225600000},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	invite, err := client.CreateInvite("new@example.com", "role2")
+	if err != nil {
+		t.Fatalf("invite creation error: %v", err)
+	}
+	if invite.Status != "sent" {
+		t.Errorf("status = %q; want %q", invite.Status, "sent")
+	}
+	if invite.ExpiresAt().UTC().Format("2006-01-02") != "2026-01-01" {
+		t.Errorf("ExpiresAt = %v; want 2026-01-01", invite.ExpiresAt().UTC())
+	}
+}
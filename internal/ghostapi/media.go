@@ -0,0 +1,68 @@
+/**
+ * media.go
+ * Media API
+ *
+ * Provides video and audio uploads (with optional thumbnails) for the Ghost Admin API.
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Media represents an uploaded video or audio file
+type Media struct {
+	URL          string `json:"url"`
+	Ref          string `json:"ref,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+// MediaUploadOptions represents options for media upload
+type MediaUploadOptions struct {
+	ContentType          string    // Content type of the media file
+	Ref                  string    // Reference ID for the media
+	Thumbnail            io.Reader // Optional thumbnail image
+	ThumbnailName        string    // Thumbnail file name
+	ThumbnailContentType string    // Thumbnail content type
+}
+
+// MediaResponse represents a media response
+type MediaResponse struct {
+	Media []Media `json:"media"`
+}
+
+// UploadMedia uploads a video or audio file
+func (c *Client) UploadMedia(file io.Reader, filename string, opts MediaUploadOptions) (*Media, error) {
+	path := "/ghost/api/admin/media/upload/"
+
+	// Build multipart files and fields
+	files := []multipartFile{{Field: "file", Reader: file, Filename: filename, ContentType: opts.ContentType}}
+	if opts.Thumbnail != nil {
+		files = append(files, multipartFile{Field: "thumbnail", Reader: opts.Thumbnail, Filename: opts.ThumbnailName, ContentType: opts.ThumbnailContentType})
+	}
+	fields := make(map[string]string)
+	if opts.Ref != "" {
+		fields["ref"] = opts.Ref
+	}
+
+	// Execute request
+	respBody, err := c.doMultipartRequestWithFiles(path, files, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp MediaResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Media) == 0 {
+		return nil, fmt.Errorf("failed to upload media")
+	}
+
+	return &resp.Media[0], nil
+}
@@ -0,0 +1,103 @@
+/**
+ * media_test.go
+ * Test code for Media and Files API
+ */
+
+package ghostapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestUploadMedia_WithThumbnail tests uploading media with a thumbnail in one request
+func TestUploadMedia_WithThumbnail(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/media/upload/" {
+			t.Errorf("request path = %q", r.URL.Path)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("file field error: %v", err)
+		}
+		file.Close()
+		if header.Filename != "clip.mp4" || header.Header.Get("Content-Type") != "video/mp4" {
+			t.Errorf("file = %q (%s)", header.Filename, header.Header.Get("Content-Type"))
+		}
+		thumb, thumbHeader, err := r.FormFile("thumbnail")
+		if err != nil {
+			t.Fatalf("thumbnail field error: %v", err)
+		}
+		data, _ := io.ReadAll(thumb)
+		thumb.Close()
+		if string(data) != "jpeg" || thumbHeader.Header.Get("Content-Type") != "image/jpeg" {
+			t.Errorf("thumbnail = %q (%s)", data, thumbHeader.Header.Get("Content-Type"))
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"media":[{"url":"https://example.com/content/media/2024/01/clip.mp4","thumbnail_url":"https://example.com/content/media/2024/01/clip_thumb.jpg","ref":null}]}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	media, err := client.UploadMedia(strings.NewReader("mp4"), "clip.mp4", MediaUploadOptions{
+		ContentType:          "video/mp4",
+		Thumbnail:            strings.NewReader("jpeg"),
+		ThumbnailName:        "poster.jpg",
+		ThumbnailContentType: "image/jpeg",
+	})
+	if err != nil {
+		t.Fatalf("upload error: %v", err)
+	}
+	if !strings.HasSuffix(media.URL, "/clip.mp4") || !strings.HasSuffix(media.ThumbnailURL, "/clip_thumb.jpg") {
+		t.Errorf("media = %+v", media)
+	}
+}
+
+// TestUploadFile_ReturnsURL tests uploading an attachment
+func TestUploadFile_ReturnsURL(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/files/upload/" {
+			t.Errorf("request path = %q", r.URL.Path)
+		}
+		_, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("file field error: %v", err)
+		}
+		if header.Header.Get("Content-Type") != "application/pdf" {
+			t.Errorf("Content-Type = %q", header.Header.Get("Content-Type"))
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"files":[{"url":"https://example.com/content/files/2024/01/report.pdf","ref":"r1"}]}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	file, err := client.UploadFile(strings.NewReader("%PDF"), "report.pdf", FileUploadOptions{ContentType: "application/pdf", Ref: "r1"})
+	if err != nil {
+		t.Fatalf("upload error: %v", err)
+	}
+	if file.URL != "https://example.com/content/files/2024/01/report.pdf" || file.Ref != "r1" {
+		t.Errorf("file = %+v", file)
+	}
+}
@@ -41,6 +41,7 @@ type MemberListOptions struct {
 	Page   int    // Page number (default: 1)
 	Filter string // Filter condition
 	Order  string // Sort order
+	Fields string // Fields to return (e.g., id,updated_at)
 }
 
 // MemberListResponse represents a member list response
@@ -79,6 +80,9 @@ func (c *Client) ListMembers(opts MemberListOptions) (*MemberListResponse, error
 	if opts.Order != "" {
 		params = append(params, fmt.Sprintf("order=%s", opts.Order))
 	}
+	if opts.Fields != "" {
+		params = append(params, fmt.Sprintf("fields=%s", opts.Fields))
+	}
 
 	if len(params) > 0 {
 		path += "?" + strings.Join(params, "&")
@@ -99,6 +103,33 @@ func (c *Client) ListMembers(opts MemberListOptions) (*MemberListResponse, error
 	return &resp, nil
 }
 
+// CountMembers returns the number of members matching an NQL filter
+func (c *Client) CountMembers(filter string) (int, error) {
+	path := "/ghost/api/admin/members/"
+
+	// Fetch a single item; only the pagination total is needed
+	reqOpts := &RequestOptions{
+		QueryParams: map[string]string{
+			"limit":  "1",
+			"filter": filter,
+		},
+	}
+
+	// Execute request
+	respBody, err := c.doRequestWithOptions("GET", path, nil, reqOpts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Parse response
+	var resp MemberListResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return 0, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return resp.Meta.Pagination.Total, nil
+}
+
 // GetMember retrieves a member by ID
 func (c *Client) GetMember(id string) (*Member, error) {
 	path := fmt.Sprintf("/ghost/api/admin/members/%s/", id)
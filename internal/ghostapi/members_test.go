@@ -383,3 +383,42 @@ func TestDeleteMember_DeleteMember(t *testing.T) {
 		t.Fatalf("Member deletion error: %v", err)
 	}
 }
+
+// TestCountMembers_ReturnsPaginationTotal tests counting members with an encoded NQL filter
+func TestCountMembers_ReturnsPaginationTotal(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify the filter survives URL encoding (including '+')
+		if r.URL.Query().Get("filter") != "newsletters.slug:weekly+(status:free)" {
+			t.Errorf("filter = %q; want %q", r.URL.Query().Get("filter"), "newsletters.slug:weekly+(status:free)")
+		}
+		if r.URL.Query().Get("limit") != "1" {
+			t.Errorf("limit = %q; want %q", r.URL.Query().Get("limit"), "1")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"members": []map[string]interface{}{{"id": "m1", "email": "a@example.com"}},
+			"meta": map[string]interface{}{
+				"pagination": map[string]interface{}{"page": 1, "limit": 1, "pages": 42, "total": 42},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	count, err := client.CountMembers("newsletters.slug:weekly+(status:free)")
+	if err != nil {
+		t.Fatalf("Failed to count members: %v", err)
+	}
+	if count != 42 {
+		t.Errorf("count = %d; want 42", count)
+	}
+}
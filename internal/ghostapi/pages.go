@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -54,7 +55,7 @@ type Page struct {
 
 	// Control
 	Visibility string `json:"visibility,omitempty"` // public, members, paid
-	Featured   bool   `json:"featured,omitempty"`
+	Featured   *bool  `json:"featured,omitempty"`   // nil leaves the setting unchanged on update
 	EmailOnly  bool   `json:"email_only,omitempty"`
 
 	// Custom
@@ -97,8 +98,8 @@ func (c *Client) ListPages(opts ListOptions) (*PageListResponse, error) {
 
 	// Build query parameters
 	params := []string{}
-	if opts.Status != "" && opts.Status != "all" {
-		params = append(params, fmt.Sprintf("filter=status:%s", opts.Status))
+	if filter := opts.filter(); filter != "" {
+		params = append(params, "filter="+url.QueryEscape(filter))
 	}
 	if opts.Limit > 0 {
 		params = append(params, fmt.Sprintf("limit=%d", opts.Limit))
@@ -109,6 +110,9 @@ func (c *Client) ListPages(opts ListOptions) (*PageListResponse, error) {
 	if opts.Include != "" {
 		params = append(params, fmt.Sprintf("include=%s", opts.Include))
 	}
+	if opts.Fields != "" {
+		params = append(params, fmt.Sprintf("fields=%s", opts.Fields))
+	}
 
 	if len(params) > 0 {
 		path += "?" + strings.Join(params, "&")
@@ -189,14 +193,7 @@ func (c *Client) CreatePageWithOptions(page *Page, opts CreateOptions) (*Page, e
 	}
 
 	// Build request options
-	var reqOpts *RequestOptions
-	if opts.Source != "" {
-		reqOpts = &RequestOptions{
-			QueryParams: map[string]string{
-				"source": opts.Source,
-			},
-		}
-	}
+	reqOpts := opts.requestOptions()
 
 	// Execute request
 	respBody, err := c.doRequestWithOptions("POST", path, bytes.NewReader(jsonData), reqOpts)
@@ -238,23 +235,13 @@ func (c *Client) UpdatePageWithOptions(id string, page *Page, opts CreateOptions
 	path := fmt.Sprintf("/ghost/api/admin/pages/%s/", id)
 
 	// Create request body
-	reqBody := map[string]interface{}{
-		"pages": []interface{}{page},
-	}
-	jsonData, err := json.Marshal(reqBody)
+	jsonData, err := updateRequestBody("pages", page, opts.Clear)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request body: %w", err)
 	}
 
 	// Build request options
-	var reqOpts *RequestOptions
-	if opts.Source != "" {
-		reqOpts = &RequestOptions{
-			QueryParams: map[string]string{
-				"source": opts.Source,
-			},
-		}
-	}
+	reqOpts := opts.requestOptions()
 
 	// Execute request
 	respBody, err := c.doRequestWithOptions("PUT", path, bytes.NewReader(jsonData), reqOpts)
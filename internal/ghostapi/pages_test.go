@@ -398,7 +398,7 @@ func TestGetPage_ExtendedFieldParsing(t *testing.T) {
 	if page.Visibility != "public" {
 		t.Errorf("Visibility = %q; want %q", page.Visibility, "public")
 	}
-	if !page.Featured {
+	if page.Featured == nil || !*page.Featured {
 		t.Errorf("Featured = %v; want %v", page.Featured, true)
 	}
 
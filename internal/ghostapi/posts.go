@@ -11,6 +11,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 )
@@ -54,7 +56,7 @@ type Post struct {
 
 	// Control
 	Visibility string `json:"visibility,omitempty"` // public, members, paid
-	Featured   bool   `json:"featured,omitempty"`
+	Featured   *bool  `json:"featured,omitempty"`   // nil leaves the setting unchanged on update
 	EmailOnly  bool   `json:"email_only,omitempty"`
 
 	// Custom
@@ -76,6 +78,29 @@ type Post struct {
 	EmailSegment           string `json:"email_segment,omitempty"`
 	NewsletterID           string `json:"newsletter_id,omitempty"`
 	SendEmailWhenPublished bool   `json:"send_email_when_published,omitempty"`
+
+	// Read-only relations (returned with include=email,count.clicks)
+	Email *Email     `json:"email,omitempty"`
+	Count *PostCount `json:"count,omitempty"`
+}
+
+// PostCount represents counts included with a post
+type PostCount struct {
+	Clicks int `json:"clicks"`
+}
+
+// PostRevision represents a saved revision of a post
+type PostRevision struct {
+	ID           string    `json:"id"`
+	PostID       string    `json:"post_id,omitempty"`
+	Title        string    `json:"title,omitempty"`
+	Lexical      string    `json:"lexical,omitempty"`
+	FeatureImage string    `json:"feature_image,omitempty"`
+	Reason       string    `json:"reason,omitempty"`      // initial_revision, explicit_save, background_save, published, etc.
+	PostStatus   string    `json:"post_status,omitempty"` // draft, published, scheduled
+	AuthorID     string    `json:"author_id,omitempty"`
+	Author       *Author   `json:"author,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
 }
 
 // ListOptions represents options for fetching post list
@@ -84,11 +109,71 @@ type ListOptions struct {
 	Limit   int    // Number of items to fetch (default: 15)
 	Page    int    // Page number (default: 1)
 	Include string // Additional information to include (tags, authors, etc.)
+	Filter  string // Additional NQL filter (combined with Status)
+	Fields  string // Fields to return (e.g., id,updated_at)
+}
+
+// filter combines the status and additional filter into an NQL expression
+func (o ListOptions) filter() string {
+	status := ""
+	if o.Status != "" && o.Status != "all" {
+		status = "status:" + o.Status
+	}
+	switch {
+	case status != "" && o.Filter != "":
+		return status + "+(" + o.Filter + ")"
+	case status != "":
+		return status
+	default:
+		return o.Filter
+	}
 }
 
 // CreateOptions contains options for creating/updating posts
 type CreateOptions struct {
-	Source string // "html" for server-side HTML-to-Lexical conversion
+	Source       string   // "html" for server-side HTML-to-Lexical conversion
+	Newsletter   string   // Newsletter slug to send the post as an email when publishing
+	EmailSegment string   // Members to email (all, status:free, status:-free, or an NQL filter)
+	Clear        []string // Fields to clear on update (sent as null, tags and authors as an empty list)
+}
+
+// requestOptions converts CreateOptions to query parameters (nil if none are set)
+func (o CreateOptions) requestOptions() *RequestOptions {
+	if o.Source == "" && o.Newsletter == "" && o.EmailSegment == "" {
+		return nil
+	}
+	return &RequestOptions{
+		QueryParams: map[string]string{
+			"source":        o.Source,
+			"newsletter":    o.Newsletter,
+			"email_segment": o.EmailSegment,
+		},
+	}
+}
+
+// updateRequestBody wraps a post or page for an update request; cleared
+// fields are sent explicitly, as omitted fields are left unchanged by Ghost
+func updateRequestBody(key string, item interface{}, clear []string) ([]byte, error) {
+	if len(clear) == 0 {
+		return json.Marshal(map[string]interface{}{key: []interface{}{item}})
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range clear {
+		if name == "tags" || name == "authors" {
+			fields[name] = json.RawMessage("[]")
+		} else {
+			fields[name] = json.RawMessage("null")
+		}
+	}
+	return json.Marshal(map[string]interface{}{key: []interface{}{fields}})
 }
 
 // PostListResponse represents a post list response
@@ -110,8 +195,8 @@ func (c *Client) ListPosts(opts ListOptions) (*PostListResponse, error) {
 
 	// Build query parameters
 	params := []string{}
-	if opts.Status != "" && opts.Status != "all" {
-		params = append(params, fmt.Sprintf("filter=status:%s", opts.Status))
+	if filter := opts.filter(); filter != "" {
+		params = append(params, "filter="+url.QueryEscape(filter))
 	}
 	if opts.Limit > 0 {
 		params = append(params, fmt.Sprintf("limit=%d", opts.Limit))
@@ -122,6 +207,9 @@ func (c *Client) ListPosts(opts ListOptions) (*PostListResponse, error) {
 	if opts.Include != "" {
 		params = append(params, fmt.Sprintf("include=%s", opts.Include))
 	}
+	if opts.Fields != "" {
+		params = append(params, fmt.Sprintf("fields=%s", opts.Fields))
+	}
 
 	if len(params) > 0 {
 		path += "?" + strings.Join(params, "&")
@@ -175,6 +263,55 @@ func (c *Client) GetPost(idOrSlug string) (*Post, error) {
 	return &response.Posts[0], nil
 }
 
+// ListPostRevisions retrieves the revision history of a post (newest first)
+func (c *Client) ListPostRevisions(id string) ([]PostRevision, error) {
+	path := fmt.Sprintf("/ghost/api/admin/posts/%s/?formats=lexical&include=post_revisions,post_revisions.author", id)
+
+	// Execute request
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var response struct {
+		Posts []struct {
+			PostRevisions []PostRevision `json:"post_revisions"`
+		} `json:"posts"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(response.Posts) == 0 {
+		return nil, fmt.Errorf("post not found: %s", id)
+	}
+
+	// Sort newest first
+	revisions := response.Posts[0].PostRevisions
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].CreatedAt.After(revisions[j].CreatedAt)
+	})
+
+	return revisions, nil
+}
+
+// GetPostRevision retrieves a single revision of a post
+func (c *Client) GetPostRevision(postID, revisionID string) (*PostRevision, error) {
+	revisions, err := c.ListPostRevisions(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range revisions {
+		if revisions[i].ID == revisionID {
+			return &revisions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("revision not found: %s", revisionID)
+}
+
 // CreatePost creates a new post
 func (c *Client) CreatePost(post *Post) (*Post, error) {
 	return c.CreatePostWithOptions(post, CreateOptions{})
@@ -194,14 +331,7 @@ func (c *Client) CreatePostWithOptions(post *Post, opts CreateOptions) (*Post, e
 	}
 
 	// Build request options
-	var reqOpts *RequestOptions
-	if opts.Source != "" {
-		reqOpts = &RequestOptions{
-			QueryParams: map[string]string{
-				"source": opts.Source,
-			},
-		}
-	}
+	reqOpts := opts.requestOptions()
 
 	// Execute request
 	respBody, err := c.doRequestWithOptions("POST", path, bytes.NewReader(jsonData), reqOpts)
@@ -234,23 +364,13 @@ func (c *Client) UpdatePostWithOptions(id string, post *Post, opts CreateOptions
 	path := fmt.Sprintf("/ghost/api/admin/posts/%s/", id)
 
 	// Create request body
-	reqBody := map[string]interface{}{
-		"posts": []interface{}{post},
-	}
-	jsonData, err := json.Marshal(reqBody)
+	jsonData, err := updateRequestBody("posts", post, opts.Clear)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request body: %w", err)
 	}
 
 	// Build request options
-	var reqOpts *RequestOptions
-	if opts.Source != "" {
-		reqOpts = &RequestOptions{
-			QueryParams: map[string]string{
-				"source": opts.Source,
-			},
-		}
-	}
+	reqOpts := opts.requestOptions()
 
 	// Execute request
 	respBody, err := c.doRequestWithOptions("PUT", path, bytes.NewReader(jsonData), reqOpts)
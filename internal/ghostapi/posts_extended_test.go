@@ -15,6 +15,7 @@ import (
 // TestPost_AllFieldsJSONConversion verifies all fields of Post struct can be converted to/from JSON
 func TestPost_AllFieldsJSONConversion(t *testing.T) {
 	// Test data: Post with all fields
+	featured := true
 	post := Post{
 		// Basic information
 		ID:     "post123",
@@ -48,7 +49,7 @@ func TestPost_AllFieldsJSONConversion(t *testing.T) {
 
 		// Control
 		Visibility: "public",
-		Featured:   true,
+		Featured:   &featured,
 		EmailOnly:  false,
 
 		// Custom
@@ -94,8 +95,8 @@ func TestPost_AllFieldsJSONConversion(t *testing.T) {
 	if restored.Visibility != post.Visibility {
 		t.Errorf("Visibility does not match: got=%s, want=%s", restored.Visibility, post.Visibility)
 	}
-	if restored.Featured != post.Featured {
-		t.Errorf("Featured does not match: got=%v, want=%v", restored.Featured, post.Featured)
+	if restored.Featured == nil || *restored.Featured != *post.Featured {
+		t.Errorf("Featured does not match: got=%v, want=%v", restored.Featured, *post.Featured)
 	}
 }
 
@@ -401,6 +401,97 @@ func TestUpdatePost_UpdatePost(t *testing.T) {
 	}
 }
 
+// TestUpdatePost_FeaturedInRequestBody tests that featured is sent when set (even if false) and omitted otherwise
+func TestUpdatePost_FeaturedInRequestBody(t *testing.T) {
+	postID := "64fac5417c4c6b0001234567"
+	var bodies []map[string]interface{}
+
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Posts []map[string]interface{} `json:"posts"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || len(reqBody.Posts) != 1 {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		bodies = append(bodies, reqBody.Posts[0])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"posts": []map[string]interface{}{{"id": postID, "title": "Post"}},
+		})
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "keyid", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	notFeatured := false
+	if _, err := client.UpdatePost(postID, &Post{Title: "Post", Featured: &notFeatured}); err != nil {
+		t.Fatalf("failed to update post: %v", err)
+	}
+	if _, err := client.UpdatePost(postID, &Post{Title: "Post"}); err != nil {
+		t.Fatalf("failed to update post: %v", err)
+	}
+
+	if value, ok := bodies[0]["featured"]; !ok || value != false {
+		t.Errorf("featured = %v (present: %v); want false", value, ok)
+	}
+	if value, ok := bodies[1]["featured"]; ok {
+		t.Errorf("featured = %v; want it omitted when unset", value)
+	}
+}
+
+// TestUpdatePost_ClearFields tests that cleared fields are sent explicitly
+func TestUpdatePost_ClearFields(t *testing.T) {
+	postID := "64fac5417c4c6b0001234567"
+	var body map[string]interface{}
+
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Posts []map[string]interface{} `json:"posts"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || len(reqBody.Posts) != 1 {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		body = reqBody.Posts[0]
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"posts": []map[string]interface{}{{"id": postID, "title": "Post"}},
+		})
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "keyid", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	opts := CreateOptions{Clear: []string{"custom_excerpt", "tags"}}
+	if _, err := client.UpdatePostWithOptions(postID, &Post{Title: "Post"}, opts); err != nil {
+		t.Fatalf("failed to update post: %v", err)
+	}
+
+	if value, ok := body["custom_excerpt"]; !ok || value != nil {
+		t.Errorf("custom_excerpt = %v (present: %v); want null", value, ok)
+	}
+	if value, ok := body["tags"].([]interface{}); !ok || len(value) != 0 {
+		t.Errorf("tags = %v; want an empty list", body["tags"])
+	}
+	if body["title"] != "Post" {
+		t.Errorf("title = %v; want %q", body["title"], "Post")
+	}
+	if _, ok := body["meta_title"]; ok {
+		t.Errorf("meta_title is present; want it omitted")
+	}
+}
+
 // TestUpdatePost_PreserveUpdatedAt tests updating a post while preserving updated_at timestamp
 func TestUpdatePost_PreserveUpdatedAt(t *testing.T) {
 	postID := "64fac5417c4c6b0001234567"
@@ -732,3 +823,161 @@ func TestUpdatePostWithOptions_WithHTMLSourceParameter(t *testing.T) {
 		t.Error("Lexical is empty (should be converted by server)")
 	}
 }
+
+// TestListPostRevisions_NewestFirst tests retrieving post revisions sorted newest first
+func TestListPostRevisions_NewestFirst(t *testing.T) {
+	postID := "64fac5417c4c6b0001234567"
+
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		expectedPath := "/ghost/api/admin/posts/" + postID + "/"
+		if r.URL.Path != expectedPath {
+			t.Errorf("request path = %q; want %q", r.URL.Path, expectedPath)
+		}
+		if r.URL.Query().Get("include") != "post_revisions,post_revisions.author" {
+			t.Errorf("include = %q; want %q", r.URL.Query().Get("include"), "post_revisions,post_revisions.author")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"posts": []map[string]interface{}{
+				{
+					"id": postID,
+					"post_revisions": []map[string]interface{}{
+						{
+							"id":         "rev-old",
+							"post_id":    postID,
+							"title":      "First draft",
+							"lexical":    `{"root":{}}`,
+							"reason":     "initial_revision",
+							"created_at": "2024-01-15T10:00:00.000Z",
+						},
+						{
+							"id":         "rev-new",
+							"post_id":    postID,
+							"title":      "Second draft",
+							"lexical":    `{"root":{}}`,
+							"reason":     "explicit_save",
+							"created_at": "2024-01-16T10:00:00.000Z",
+							"author":     map[string]interface{}{"id": "user1", "name": "Editor"},
+						},
+					},
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "keyid", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	revisions, err := client.ListPostRevisions(postID)
+	if err != nil {
+		t.Fatalf("failed to list revisions: %v", err)
+	}
+
+	// Verify order and fields
+	if len(revisions) != 2 {
+		t.Fatalf("number of revisions = %d; want 2", len(revisions))
+	}
+	if revisions[0].ID != "rev-new" {
+		t.Errorf("first revision = %q; want %q", revisions[0].ID, "rev-new")
+	}
+	if revisions[0].Author == nil || revisions[0].Author.Name != "Editor" {
+		t.Errorf("author = %+v; want Editor", revisions[0].Author)
+	}
+
+	// Look up a single revision
+	rev, err := client.GetPostRevision(postID, "rev-old")
+	if err != nil {
+		t.Fatalf("failed to get revision: %v", err)
+	}
+	if rev.Reason != "initial_revision" {
+		t.Errorf("reason = %q; want %q", rev.Reason, "initial_revision")
+	}
+	if _, err := client.GetPostRevision(postID, "missing"); err == nil {
+		t.Error("expected error for unknown revision")
+	}
+}
+
+// TestUpdatePostWithOptions_NewsletterQueryParams tests passing newsletter and email_segment query parameters
+func TestUpdatePostWithOptions_NewsletterQueryParams(t *testing.T) {
+	postID := "64fac5417c4c6b0001234567"
+
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify query parameters
+		if r.URL.Query().Get("newsletter") != "weekly" {
+			t.Errorf("newsletter = %q; want %q", r.URL.Query().Get("newsletter"), "weekly")
+		}
+		if r.URL.Query().Get("email_segment") != "status:-free" {
+			t.Errorf("email_segment = %q; want %q", r.URL.Query().Get("email_segment"), "status:-free")
+		}
+		if r.URL.Query().Has("source") {
+			t.Errorf("source should not be set, got %q", r.URL.Query().Get("source"))
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"posts": []map[string]interface{}{
+				{"id": postID, "title": "Post", "status": "published"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "keyid", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = client.UpdatePostWithOptions(postID, &Post{Title: "Post", Status: "published"}, CreateOptions{
+		Newsletter:   "weekly",
+		EmailSegment: "status:-free",
+	})
+	if err != nil {
+		t.Fatalf("failed to update post: %v", err)
+	}
+}
+
+// TestListPosts_FieldsAndIDFilter tests requesting selected fields and filtering by IDs
+func TestListPosts_FieldsAndIDFilter(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify query parameters
+		if got := r.URL.Query().Get("fields"); got != "id,updated_at" {
+			t.Errorf("fields = %q; want %q", got, "id,updated_at")
+		}
+		if got := r.URL.Query().Get("filter"); got != "id:[a,b]" {
+			t.Errorf("filter = %q; want %q", got, "id:[a,b]")
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"posts":[{"id":"a","updated_at":"2024-01-15T10:00:00.000Z"}],"meta":{"pagination":{"page":1,"pages":1}}}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	resp, err := client.ListPosts(ListOptions{Status: "all", Filter: "id:[a,b]", Fields: "id,updated_at"})
+	if err != nil {
+		t.Fatalf("list error: %v", err)
+	}
+	if len(resp.Posts) != 1 || resp.Posts[0].UpdatedAt.IsZero() {
+		t.Errorf("posts = %+v", resp.Posts)
+	}
+}
@@ -0,0 +1,32 @@
+/**
+ * redirects.go
+ * Redirects API
+ *
+ * Provides download and upload of the redirects file for the Ghost Admin API.
+ * The file is uploaded as a whole; Ghost replaces all existing redirects.
+ */
+
+package ghostapi
+
+import (
+	"io"
+)
+
+// DownloadRedirects retrieves the current redirects file (JSON or YAML, as uploaded)
+func (c *Client) DownloadRedirects() ([]byte, error) {
+	path := "/ghost/api/admin/redirects/download/"
+
+	// Execute request
+	return c.doRequest("GET", path, nil)
+}
+
+// UploadRedirects replaces the redirects file
+//
+// The filename extension (.json or .yaml) tells Ghost how to parse the file.
+func (c *Client) UploadRedirects(file io.Reader, filename string) error {
+	path := "/ghost/api/admin/redirects/upload/"
+
+	// Execute multipart request
+	_, err := c.doMultipartRequestWithField(path, "redirects", file, filename, nil)
+	return err
+}
@@ -0,0 +1,79 @@
+/**
+ * redirects_test.go
+ * Test code for Redirects API
+ */
+
+package ghostapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestDownloadRedirects_ReturnsRawFile tests downloading the redirects file as-is
+func TestDownloadRedirects_ReturnsRawFile(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/redirects/download/" {
+			t.Errorf("request path = %q; want %q", r.URL.Path, "/ghost/api/admin/redirects/download/")
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write([]byte("301:\n  /old/: /new/\n"))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	data, err := client.DownloadRedirects()
+	if err != nil {
+		t.Fatalf("redirects download error: %v", err)
+	}
+	if string(data) != "301:\n  /old/: /new/\n" {
+		t.Errorf("data = %q", data)
+	}
+}
+
+// TestUploadRedirects_SendsFile tests uploading the redirects file
+func TestUploadRedirects_SendsFile(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/redirects/upload/" {
+			t.Errorf("request path = %q; want %q", r.URL.Path, "/ghost/api/admin/redirects/upload/")
+		}
+		file, header, err := r.FormFile("redirects")
+		if err != nil {
+			t.Fatalf("form file error: %v", err)
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		if header.Filename != "redirects.yaml" || !strings.Contains(string(content), "/old/") {
+			t.Errorf("file = %s %q", header.Filename, content)
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	if err := client.UploadRedirects(strings.NewReader("301:\n  /old/: /new/\n"), "redirects.yaml"); err != nil {
+		t.Fatalf("redirects upload error: %v", err)
+	}
+}
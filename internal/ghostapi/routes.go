@@ -0,0 +1,29 @@
+/**
+ * routes.go
+ * Routes API
+ *
+ * Provides download and upload of routes.yaml (dynamic routing) for the Ghost Admin API.
+ */
+
+package ghostapi
+
+import (
+	"io"
+)
+
+// DownloadRoutes retrieves the current routes.yaml
+func (c *Client) DownloadRoutes() ([]byte, error) {
+	path := "/ghost/api/admin/settings/routes/yaml/"
+
+	// Execute request
+	return c.doRequest("GET", path, nil)
+}
+
+// UploadRoutes replaces routes.yaml
+func (c *Client) UploadRoutes(file io.Reader, filename string) error {
+	path := "/ghost/api/admin/settings/routes/yaml/"
+
+	// Execute multipart request
+	_, err := c.doMultipartRequestWithField(path, "routes", file, filename, nil)
+	return err
+}
@@ -0,0 +1,52 @@
+/**
+ * routes_test.go
+ * Test code for Routes API
+ */
+
+package ghostapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestUploadRoutes_SendsRoutesField tests uploading routes.yaml in the routes form field
+func TestUploadRoutes_SendsRoutesField(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/settings/routes/yaml/" {
+			t.Errorf("request path = %q; want %q", r.URL.Path, "/ghost/api/admin/settings/routes/yaml/")
+		}
+		if r.Method != "POST" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "POST")
+		}
+		file, header, err := r.FormFile("routes")
+		if err != nil {
+			t.Fatalf("form file error: %v", err)
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		if header.Filename != "routes.yaml" || !strings.HasPrefix(string(content), "routes:") {
+			t.Errorf("file = %s %q", header.Filename, content)
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	if err := client.UploadRoutes(strings.NewReader("routes:\n"), "routes.yaml"); err != nil {
+		t.Fatalf("routes upload error: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+/**
+ * snippets.go
+ * Snippets API
+ *
+ * Provides Snippets functionality for the Ghost Admin API.
+ * Snippets are reusable content blocks stored as Lexical JSON.
+ */
+
+package ghostapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Snippet represents a Ghost snippet
+type Snippet struct {
+	ID        string    `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Lexical   string    `json:"lexical,omitempty"`
+	Mobiledoc string    `json:"mobiledoc,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// SnippetListOptions represents options for fetching snippet list
+type SnippetListOptions struct {
+	Limit  int    // Number of items to fetch (default: 15)
+	Page   int    // Page number (default: 1)
+	Filter string // Filter condition
+}
+
+// SnippetListResponse represents a snippet list response
+type SnippetListResponse struct {
+	Snippets []Snippet `json:"snippets"`
+	Meta     struct {
+		Pagination struct {
+			Page  int `json:"page"`
+			Limit int `json:"limit"`
+			Pages int `json:"pages"`
+			Total int `json:"total"`
+		} `json:"pagination"`
+	} `json:"meta"`
+}
+
+// SnippetResponse represents a single snippet response
+type SnippetResponse struct {
+	Snippets []Snippet `json:"snippets"`
+}
+
+// ListSnippets retrieves a list of snippets
+func (c *Client) ListSnippets(opts SnippetListOptions) (*SnippetListResponse, error) {
+	path := "/ghost/api/admin/snippets/"
+
+	// Build query parameters (always request Lexical content)
+	params := []string{"formats=lexical"}
+	if opts.Limit > 0 {
+		params = append(params, fmt.Sprintf("limit=%d", opts.Limit))
+	}
+	if opts.Page > 0 {
+		params = append(params, fmt.Sprintf("page=%d", opts.Page))
+	}
+	if opts.Filter != "" {
+		params = append(params, fmt.Sprintf("filter=%s", opts.Filter))
+	}
+
+	path += "?" + strings.Join(params, "&")
+
+	// Execute request
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp SnippetListResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// GetSnippet retrieves a snippet by ID
+func (c *Client) GetSnippet(id string) (*Snippet, error) {
+	path := fmt.Sprintf("/ghost/api/admin/snippets/%s/?formats=lexical", id)
+
+	// Execute request
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp SnippetResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Snippets) == 0 {
+		return nil, fmt.Errorf("snippet not found: %s", id)
+	}
+
+	return &resp.Snippets[0], nil
+}
+
+// CreateSnippet creates a new snippet
+func (c *Client) CreateSnippet(snippet *Snippet) (*Snippet, error) {
+	path := "/ghost/api/admin/snippets/?formats=lexical"
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"snippets": []Snippet{*snippet},
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	// Execute request
+	respBody, err := c.doRequest("POST", path, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp SnippetResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Snippets) == 0 {
+		return nil, fmt.Errorf("failed to create snippet")
+	}
+
+	return &resp.Snippets[0], nil
+}
+
+// UpdateSnippet updates an existing snippet
+func (c *Client) UpdateSnippet(id string, snippet *Snippet) (*Snippet, error) {
+	path := fmt.Sprintf("/ghost/api/admin/snippets/%s/?formats=lexical", id)
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"snippets": []Snippet{*snippet},
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	// Execute request
+	respBody, err := c.doRequest("PUT", path, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp SnippetResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Snippets) == 0 {
+		return nil, fmt.Errorf("failed to update snippet")
+	}
+
+	return &resp.Snippets[0], nil
+}
+
+// DeleteSnippet deletes a snippet
+func (c *Client) DeleteSnippet(id string) error {
+	path := fmt.Sprintf("/ghost/api/admin/snippets/%s/", id)
+
+	// Execute request
+	_, err := c.doRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
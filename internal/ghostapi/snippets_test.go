@@ -0,0 +1,164 @@
+/**
+ * snippets_test.go
+ * Test code for Snippets API
+ */
+
+package ghostapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestListSnippets_GetSnippetList tests retrieving a list of snippets
+func TestListSnippets_GetSnippetList(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/snippets/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/snippets/")
+		}
+		if r.Method != "GET" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "GET")
+		}
+		if r.URL.Query().Get("formats") != "lexical" {
+			t.Errorf("formats = %q; want %q", r.URL.Query().Get("formats"), "lexical")
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"snippets": []map[string]interface{}{
+				{
+					"id":         "64fac5417c4c6b0001234567",
+					"name":       "Disclaimer",
+					"lexical":    `{"root":{"children":[]}}`,
+					"created_at": "2024-01-15T10:00:00.000Z",
+					"updated_at": "2024-01-15T10:00:00.000Z",
+				},
+			},
+			"meta": map[string]interface{}{
+				"pagination": map[string]interface{}{
+					"page":  1,
+					"limit": 15,
+					"pages": 1,
+					"total": 1,
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Get snippet list
+	resp, err := client.ListSnippets(SnippetListOptions{Limit: 15})
+	if err != nil {
+		t.Fatalf("Failed to get snippet list: %v", err)
+	}
+
+	// Verify response
+	if len(resp.Snippets) != 1 {
+		t.Fatalf("Number of snippets = %d; want 1", len(resp.Snippets))
+	}
+	if resp.Snippets[0].Name != "Disclaimer" {
+		t.Errorf("Snippet name = %q; want %q", resp.Snippets[0].Name, "Disclaimer")
+	}
+	if resp.Meta.Pagination.Total != 1 {
+		t.Errorf("Total = %d; want 1", resp.Meta.Pagination.Total)
+	}
+}
+
+// TestCreateSnippet_CreateSnippet tests creating a snippet
+func TestCreateSnippet_CreateSnippet(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/snippets/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/snippets/")
+		}
+		if r.Method != "POST" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "POST")
+		}
+
+		// Verify request body
+		var reqBody map[string][]Snippet
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("request body parse error: %v", err)
+		}
+		if len(reqBody["snippets"]) != 1 || reqBody["snippets"][0].Name != "CTA" {
+			t.Errorf("request body = %v; want snippet named CTA", reqBody)
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"snippets": []map[string]interface{}{
+				{
+					"id":      "64fac5417c4c6b0001234568",
+					"name":    "CTA",
+					"lexical": reqBody["snippets"][0].Lexical,
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Create snippet
+	created, err := client.CreateSnippet(&Snippet{Name: "CTA", Lexical: `{"root":{}}`})
+	if err != nil {
+		t.Fatalf("Failed to create snippet: %v", err)
+	}
+
+	// Verify response
+	if created.ID != "64fac5417c4c6b0001234568" {
+		t.Errorf("Snippet ID = %q; want %q", created.ID, "64fac5417c4c6b0001234568")
+	}
+	if created.Lexical != `{"root":{}}` {
+		t.Errorf("Snippet lexical = %q; want %q", created.Lexical, `{"root":{}}`)
+	}
+}
+
+// TestDeleteSnippet_DeleteSnippet tests deleting a snippet
+func TestDeleteSnippet_DeleteSnippet(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/ghost/api/admin/snippets/64fac5417c4c6b0001234567/" {
+			t.Errorf("Request path = %q; want %q", r.URL.Path, "/ghost/api/admin/snippets/64fac5417c4c6b0001234567/")
+		}
+		if r.Method != "DELETE" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "DELETE")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Delete snippet
+	if err := client.DeleteSnippet("64fac5417c4c6b0001234567"); err != nil {
+		t.Fatalf("Failed to delete snippet: %v", err)
+	}
+}
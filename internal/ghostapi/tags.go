@@ -32,6 +32,7 @@ type TagListOptions struct {
 	Page    int    // Page number (default: 1)
 	Include string // Additional information to include (count.posts, etc.)
 	Filter  string // Filter condition
+	Fields  string // Fields to return (e.g., id,updated_at)
 }
 
 // TagListResponse represents a tag list response
@@ -67,6 +68,9 @@ func (c *Client) ListTags(opts TagListOptions) (*TagListResponse, error) {
 	if opts.Include != "" {
 		params = append(params, fmt.Sprintf("include=%s", opts.Include))
 	}
+	if opts.Fields != "" {
+		params = append(params, fmt.Sprintf("fields=%s", opts.Fields))
+	}
 	if opts.Filter != "" {
 		params = append(params, fmt.Sprintf("filter=%s", opts.Filter))
 	}
@@ -85,6 +85,14 @@ func (c *Client) UploadTheme(file io.Reader, filename string) (*Theme, error) {
 	return &resp.Themes[0], nil
 }
 
+// DownloadTheme downloads an installed theme as a zip file
+func (c *Client) DownloadTheme(name string) ([]byte, error) {
+	path := fmt.Sprintf("/ghost/api/admin/themes/%s/download/", name)
+
+	// Execute request
+	return c.doRequest("GET", path, nil)
+}
+
 // ActivateTheme activates a theme
 func (c *Client) ActivateTheme(name string) (*Theme, error) {
 	path := fmt.Sprintf("/ghost/api/admin/themes/%s/activate/", name)
@@ -268,3 +268,33 @@ func TestDeleteTheme_DeleteTheme(t *testing.T) {
 		t.Fatalf("Failed to delete theme: %v", err)
 	}
 }
+
+// TestDownloadTheme_ReturnsZip tests downloading an installed theme
+func TestDownloadTheme_ReturnsZip(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.URL.Path != "/ghost/api/admin/themes/casper/download/" || r.Method != "GET" {
+			t.Errorf("request = %s %s; want GET /ghost/api/admin/themes/casper/download/", r.Method, r.URL.Path)
+		}
+
+		// Return response
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write([]byte("PK\x03\x04zip"))
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	data, err := client.DownloadTheme("casper")
+	if err != nil {
+		t.Fatalf("download error: %v", err)
+	}
+	if string(data) != "PK\x03\x04zip" {
+		t.Errorf("data = %q", data)
+	}
+}
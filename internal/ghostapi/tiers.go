@@ -195,3 +195,57 @@ func (c *Client) UpdateTier(id string, tier *Tier) (*Tier, error) {
 
 	return &resp.Tiers[0], nil
 }
+
+// ArchiveTier archives a tier (it can no longer be chosen by new members)
+func (c *Client) ArchiveTier(id string) (*Tier, error) {
+	return c.editTier(id, map[string]interface{}{"active": false})
+}
+
+// UnarchiveTier restores an archived tier
+func (c *Client) UnarchiveTier(id string) (*Tier, error) {
+	return c.editTier(id, map[string]interface{}{"active": true})
+}
+
+// SetTierBenefits replaces the benefits of a tier (an empty list clears them)
+func (c *Client) SetTierBenefits(id string, benefits []string) (*Tier, error) {
+	if benefits == nil {
+		benefits = []string{}
+	}
+	return c.editTier(id, map[string]interface{}{"benefits": benefits})
+}
+
+// editTier sends a partial update of a tier
+//
+// Unlike UpdateTier, only the given fields are sent, so false and empty
+// values (which are omitted from Tier) can be set explicitly.
+func (c *Client) editTier(id string, fields map[string]interface{}) (*Tier, error) {
+	path := fmt.Sprintf("/ghost/api/admin/tiers/%s/", id)
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"tiers": []interface{}{fields},
+	}
+
+	reqBodyJSON, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	// Execute request
+	respBody, err := c.doRequest("PUT", path, bytes.NewReader(reqBodyJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp TierResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Tiers) == 0 {
+		return nil, fmt.Errorf("failed to update tier")
+	}
+
+	return &resp.Tiers[0], nil
+}
@@ -440,3 +440,91 @@ func TestUpdateTier_UpdateTier(t *testing.T) {
 		t.Errorf("description = %q; want %q", updatedTier.Description, "Updated description")
 	}
 }
+
+// TestArchiveTier_SendsActiveFalse tests that archiving explicitly sends active=false
+func TestArchiveTier_SendsActiveFalse(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate the request
+		if r.Method != "PUT" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "PUT")
+		}
+
+		// Validate request body (active must be present even though false)
+		var reqBody struct {
+			Tiers []map[string]interface{} `json:"tiers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("request body parse error: %v", err)
+		}
+		active, ok := reqBody.Tiers[0]["active"]
+		if !ok || active != false {
+			t.Errorf("active = %v (present: %t); want false", active, ok)
+		}
+		if len(reqBody.Tiers[0]) != 1 {
+			t.Errorf("tier fields = %v; want only active", reqBody.Tiers[0])
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"tiers": []map[string]interface{}{
+				{"id": "tier1", "name": "Premium", "active": false},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	tier, err := client.ArchiveTier("tier1")
+	if err != nil {
+		t.Fatalf("tier archive error: %v", err)
+	}
+	if tier.Active {
+		t.Error("tier should be archived")
+	}
+}
+
+// TestSetTierBenefits_EmptyListClearsBenefits tests that an empty list is sent as []
+func TestSetTierBenefits_EmptyListClearsBenefits(t *testing.T) {
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Validate request body
+		var reqBody struct {
+			Tiers []map[string]interface{} `json:"tiers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("request body parse error: %v", err)
+		}
+		benefits, ok := reqBody.Tiers[0]["benefits"].([]interface{})
+		if !ok || len(benefits) != 0 {
+			t.Errorf("benefits = %v; want []", reqBody.Tiers[0]["benefits"])
+		}
+
+		// Return response
+		response := map[string]interface{}{
+			"tiers": []map[string]interface{}{
+				{"id": "tier1", "name": "Premium", "benefits": []string{}},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	if _, err := client.SetTierBenefits("tier1", nil); err != nil {
+		t.Fatalf("tier benefits update error: %v", err)
+	}
+}
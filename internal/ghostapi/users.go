@@ -26,15 +26,17 @@ type User struct {
 	Website      string    `json:"website,omitempty"`
 	ProfileImage string    `json:"profile_image,omitempty"`
 	CoverImage   string    `json:"cover_image,omitempty"`
-	Roles        []Role    `json:"roles,omitempty"` // Read-only
+	Status       string    `json:"status,omitempty"` // active, inactive (suspended), locked
+	Roles        []Role    `json:"roles,omitempty"`  // Read-only (use SetUserRole)
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	UpdatedAt    time.Time `json:"updated_at,omitempty"`
 }
 
 // Role represents a user role
 type Role struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
 }
 
 // UserListOptions contains options for listing users
@@ -165,3 +167,141 @@ func (c *Client) UpdateUser(id string, user *User) (*User, error) {
 
 	return &resp.Users[0], nil
 }
+
+// SetUserRole changes the role of a user
+func (c *Client) SetUserRole(id string, role Role) (*User, error) {
+	return c.editUser(id, map[string]interface{}{
+		"roles": []Role{role},
+	})
+}
+
+// SetUserStatus changes the status of a user ("inactive" suspends, "active" restores)
+func (c *Client) SetUserStatus(id, status string) (*User, error) {
+	return c.editUser(id, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// DeleteUser deletes a user
+//
+// Ghost assigns any remaining posts of the user to the site owner.
+// Use ReassignUserContent first to move them to a specific author.
+func (c *Client) DeleteUser(id string) error {
+	path := fmt.Sprintf("/ghost/api/admin/users/%s/", id)
+
+	// Execute request
+	_, err := c.doRequest("DELETE", path, nil)
+	return err
+}
+
+// ReassignUserContent moves posts and pages authored by a user to another author
+//
+// The user is replaced in each author list (keeping co-authors and order).
+// Returns the number of posts and pages updated.
+func (c *Client) ReassignUserContent(from *User, to *User) (int, error) {
+	filter := "authors:" + from.Slug
+	count := 0
+
+	// Collect all posts first, since updates change the filter results
+	var posts []Post
+	for page := 1; ; page++ {
+		resp, err := c.ListPosts(ListOptions{Status: "all", Limit: 100, Page: page, Include: "authors", Filter: filter})
+		if err != nil {
+			return count, fmt.Errorf("failed to list posts: %w", err)
+		}
+		posts = append(posts, resp.Posts...)
+		if page >= resp.Meta.Pagination.Pages {
+			break
+		}
+	}
+	for _, post := range posts {
+		update := &Post{
+			Title:     post.Title,
+			Status:    post.Status,
+			Authors:   replaceAuthor(post.Authors, from.ID, to.ID),
+			UpdatedAt: post.UpdatedAt,
+		}
+		if _, err := c.UpdatePost(post.ID, update); err != nil {
+			return count, fmt.Errorf("failed to reassign post '%s': %w", post.Title, err)
+		}
+		count++
+	}
+
+	// Collect all pages
+	var pages []Page
+	for page := 1; ; page++ {
+		resp, err := c.ListPages(ListOptions{Status: "all", Limit: 100, Page: page, Include: "authors", Filter: filter})
+		if err != nil {
+			return count, fmt.Errorf("failed to list pages: %w", err)
+		}
+		pages = append(pages, resp.Pages...)
+		if page >= resp.Meta.Pagination.Pages {
+			break
+		}
+	}
+	for _, p := range pages {
+		update := &Page{
+			Title:     p.Title,
+			Status:    p.Status,
+			Authors:   replaceAuthor(p.Authors, from.ID, to.ID),
+			UpdatedAt: p.UpdatedAt,
+		}
+		if _, err := c.UpdatePage(p.ID, update); err != nil {
+			return count, fmt.Errorf("failed to reassign page '%s': %w", p.Title, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// replaceAuthor replaces an author ID in an author list, avoiding duplicates
+func replaceAuthor(authors []Author, fromID, toID string) []Author {
+	result := make([]Author, 0, len(authors))
+	seen := make(map[string]bool, len(authors))
+	for _, author := range authors {
+		id := author.ID
+		if id == fromID {
+			id = toID
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, Author{ID: id})
+	}
+	return result
+}
+
+// editUser sends a partial update of a user
+func (c *Client) editUser(id string, fields map[string]interface{}) (*User, error) {
+	path := fmt.Sprintf("/ghost/api/admin/users/%s/", id)
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"users": []interface{}{fields},
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	// Execute request
+	respBody, err := c.doRequest("PUT", path, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var resp UserResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(resp.Users) == 0 {
+		return nil, fmt.Errorf("failed to update user")
+	}
+
+	return &resp.Users[0], nil
+}
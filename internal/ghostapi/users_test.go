@@ -388,3 +388,87 @@ func TestListUsers_APIError(t *testing.T) {
 		t.Fatal("Expected error but no error was returned")
 	}
 }
+
+// TestReassignUserContent_ReplacesAuthor tests moving posts and pages to another author
+func TestReassignUserContent_ReplacesAuthor(t *testing.T) {
+	var updatedPosts, updatedPages []map[string]interface{}
+
+	// Create test HTTP server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		pagination := map[string]interface{}{"pagination": map[string]interface{}{"page": 1, "limit": 100, "pages": 1, "total": 1}}
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/ghost/api/admin/posts/":
+			// Validate author filter
+			if r.URL.Query().Get("filter") != "authors:old-author" {
+				t.Errorf("filter = %q; want %q", r.URL.Query().Get("filter"), "authors:old-author")
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"posts": []map[string]interface{}{
+					{
+						"id": "post1", "title": "Co-written", "status": "published", "updated_at": "2026-01-01T00:00:00.000Z",
+						"authors": []map[string]interface{}{{"id": "old"}, {"id": "other"}, {"id": "new"}},
+					},
+				},
+				"meta": pagination,
+			})
+		case r.Method == "GET" && r.URL.Path == "/ghost/api/admin/pages/":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"pages": []map[string]interface{}{
+					{
+						"id": "page1", "title": "About", "status": "published", "updated_at": "2026-01-01T00:00:00.000Z",
+						"authors": []map[string]interface{}{{"id": "old"}},
+					},
+				},
+				"meta": pagination,
+			})
+		case r.Method == "PUT" && r.URL.Path == "/ghost/api/admin/posts/post1/":
+			var reqBody struct {
+				Posts []map[string]interface{} `json:"posts"`
+			}
+			json.NewDecoder(r.Body).Decode(&reqBody)
+			updatedPosts = append(updatedPosts, reqBody.Posts[0])
+			json.NewEncoder(w).Encode(map[string]interface{}{"posts": []map[string]interface{}{{"id": "post1", "title": "Co-written"}}})
+		case r.Method == "PUT" && r.URL.Path == "/ghost/api/admin/pages/page1/":
+			var reqBody struct {
+				Pages []map[string]interface{} `json:"pages"`
+			}
+			json.NewDecoder(r.Body).Decode(&reqBody)
+			updatedPages = append(updatedPages, reqBody.Pages[0])
+			json.NewEncoder(w).Encode(map[string]interface{}{"pages": []map[string]interface{}{{"id": "page1", "title": "About"}}})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	// Create client
+	client, err := NewClient(server.URL, "test-key", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatalf("client creation error: %v", err)
+	}
+
+	count, err := client.ReassignUserContent(&User{ID: "old", Slug: "old-author"}, &User{ID: "new", Slug: "new-author"})
+	if err != nil {
+		t.Fatalf("reassign error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d; want 2", count)
+	}
+
+	// The old author is replaced without duplicating the new author
+	if len(updatedPosts) != 1 {
+		t.Fatalf("updated posts = %d; want 1", len(updatedPosts))
+	}
+	authors := updatedPosts[0]["authors"].([]interface{})
+	if len(authors) != 2 || authors[0].(map[string]interface{})["id"] != "new" || authors[1].(map[string]interface{})["id"] != "other" {
+		t.Errorf("post authors = %v; want [new other]", authors)
+	}
+	if updatedPosts[0]["updated_at"] == nil {
+		t.Error("updated_at must be sent for optimistic locking")
+	}
+	if len(updatedPages) != 1 {
+		t.Fatalf("updated pages = %d; want 1", len(updatedPages))
+	}
+}
@@ -0,0 +1,257 @@
+/**
+ * webhook_trigger.go
+ * Webhook test delivery
+ *
+ * Builds sample webhook payloads in the same shape Ghost sends them,
+ * signs them with the same HMAC scheme, and delivers them to any endpoint.
+ * Used to test downstream receivers without triggering real events.
+ */
+
+package ghostapi
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WebhookEvents is the catalog of events Ghost can send webhooks for
+var WebhookEvents = []string{
+	"site.changed",
+	"post.added",
+	"post.deleted",
+	"post.edited",
+	"post.published",
+	"post.published.edited",
+	"post.unpublished",
+	"post.scheduled",
+	"post.unscheduled",
+	"post.rescheduled",
+	"page.added",
+	"page.deleted",
+	"page.edited",
+	"page.published",
+	"page.published.edited",
+	"page.unpublished",
+	"page.scheduled",
+	"page.unscheduled",
+	"page.rescheduled",
+	"tag.added",
+	"tag.edited",
+	"tag.deleted",
+	"post.tag.attached",
+	"post.tag.detached",
+	"page.tag.attached",
+	"page.tag.detached",
+	"member.added",
+	"member.edited",
+	"member.deleted",
+}
+
+// WebhookSignatureHeader is the header Ghost uses to carry the payload signature
+const WebhookSignatureHeader = "X-Ghost-Signature"
+
+// WebhookDelivery represents the result of a test webhook delivery
+type WebhookDelivery struct {
+	Event      string `json:"event"`
+	TargetURL  string `json:"target_url"`
+	StatusCode int    `json:"status_code"`
+	Signature  string `json:"signature,omitempty"`
+	Response   string `json:"response,omitempty"`
+}
+
+// IsValidWebhookEvent reports whether the event is in Ghost's event catalog
+func IsValidWebhookEvent(event string) bool {
+	for _, e := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookResource returns the resource key used in the payload for an event
+// (post, page, tag, member). Returns an empty string for site.changed.
+func WebhookResource(event string) string {
+	if event == "site.changed" {
+		return ""
+	}
+	// post.tag.attached and friends carry the post/page, not the tag
+	resource, _, _ := strings.Cut(event, ".")
+	return resource
+}
+
+// BuildWebhookPayload builds a payload in the shape Ghost sends for the event
+//
+// Ghost wraps the resource as {"<resource>": {"current": {...}, "previous": {...}}}.
+// For deleted events the resource is sent as "previous" and "current" is empty.
+// site.changed events carry an empty object.
+func BuildWebhookPayload(event string, resource interface{}) (map[string]interface{}, error) {
+	if !IsValidWebhookEvent(event) {
+		return nil, fmt.Errorf("unknown webhook event: %s", event)
+	}
+
+	key := WebhookResource(event)
+	if key == "" {
+		return map[string]interface{}{}, nil
+	}
+
+	// Round-trip through JSON so structs are sent with their API field names
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+
+	current := obj
+	previous := map[string]interface{}{}
+	if strings.HasSuffix(event, ".deleted") {
+		current = map[string]interface{}{}
+		previous = obj
+	}
+
+	return map[string]interface{}{
+		key: map[string]interface{}{
+			"current":  current,
+			"previous": previous,
+		},
+	}, nil
+}
+
+// SignWebhookPayload computes the X-Ghost-Signature header value
+//
+// Ghost signs the serialized body followed by the millisecond timestamp with
+// HMAC-SHA256 and sends "sha256=<hex>, t=<timestamp>".
+func SignWebhookPayload(body []byte, secret string, ts time.Time) string {
+	millis := ts.UnixMilli()
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	mac.Write([]byte(fmt.Sprintf("%d", millis)))
+	return fmt.Sprintf("sha256=%s, t=%d", hex.EncodeToString(mac.Sum(nil)), millis)
+}
+
+// SendWebhook delivers a payload to the target URL the way Ghost does
+// The payload is signed only when secret is non-empty, matching Ghost's behavior.
+func SendWebhook(targetURL, secret, event string, payload map[string]interface{}) (*WebhookDelivery, error) {
+	// Serialize like JSON.stringify (no HTML escaping, no trailing newline)
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(payload); err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+	body := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+
+	req, err := http.NewRequest("POST", targetURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Ghost (https://github.com/TryGhost/Ghost)")
+
+	delivery := &WebhookDelivery{
+		Event:     event,
+		TargetURL: targetURL,
+	}
+	if secret != "" {
+		delivery.Signature = SignWebhookPayload(body, secret, time.Now())
+		req.Header.Set(WebhookSignatureHeader, delivery.Signature)
+	}
+
+	// Execute request
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	delivery.StatusCode = resp.StatusCode
+	delivery.Response = string(respBody)
+
+	return delivery, nil
+}
+
+// WebhookFixture returns a built-in sample resource for the event
+// Used when no real post or member is specified.
+func WebhookFixture(event string) interface{} {
+	created := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	published := created.Add(time.Hour)
+	author := Author{ID: "5951f5fca366002ebd5dbef7", Name: "Jamie Larson", Slug: "jamie"}
+	tag := Tag{
+		ID:         "59799bbd6ebb2f00243a33db",
+		Name:       "Getting Started",
+		Slug:       "getting-started",
+		Visibility: "public",
+		CreatedAt:  created,
+		UpdatedAt:  created,
+	}
+
+	switch WebhookResource(event) {
+	case "post":
+		return &Post{
+			ID:            "5b7ada404f87d200b5b1f9c8",
+			UUID:          "c4aa1e63-1a5b-4e54-a3e8-6b9fbf2e84b2",
+			Title:         "Welcome to Ghost",
+			Slug:          "welcome",
+			Status:        "published",
+			URL:           "https://example.com/welcome/",
+			HTML:          "<p>👋 Welcome, it's great to have you here.</p>",
+			Excerpt:       "Welcome, it's great to have you here.",
+			Visibility:    "public",
+			CreatedAt:     created,
+			UpdatedAt:     published,
+			PublishedAt:   &published,
+			Tags:          []Tag{tag},
+			Authors:       []Author{author},
+			PrimaryAuthor: &author,
+			PrimaryTag:    &tag,
+			ReadingTime:   1,
+		}
+	case "page":
+		return &Page{
+			ID:            "5b7ada404f87d200b5b1f9c9",
+			UUID:          "0e6a4d6f-8a1d-4c4e-9ad1-1f2e7a9a4b10",
+			Title:         "About",
+			Slug:          "about",
+			Status:        "published",
+			URL:           "https://example.com/about/",
+			HTML:          "<p>This is an independent publication.</p>",
+			Visibility:    "public",
+			CreatedAt:     created,
+			UpdatedAt:     published,
+			PublishedAt:   &published,
+			Authors:       []Author{author},
+			PrimaryAuthor: &author,
+		}
+	case "tag":
+		return &tag
+	case "member":
+		return &Member{
+			ID:        "5c9c9c8d51b5bf974afad2a4",
+			UUID:      "b7a0e2a2-3f1a-4a4f-9a33-7a3d2e1f0c11",
+			Email:     "member@example.com",
+			Name:      "Sample Member",
+			Status:    "free",
+			CreatedAt: created,
+			UpdatedAt: created,
+		}
+	default:
+		return nil
+	}
+}
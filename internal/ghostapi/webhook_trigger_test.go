@@ -0,0 +1,166 @@
+/**
+ * webhook_trigger_test.go
+ * Test code for webhook test delivery
+ */
+
+package ghostapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestIsValidWebhookEvent validates events against Ghost's catalog
+func TestIsValidWebhookEvent(t *testing.T) {
+	testCases := []struct {
+		event string
+		want  bool
+	}{
+		{"post.published", true},
+		{"member.added", true},
+		{"site.changed", true},
+		{"post.tag.attached", true},
+		{"post.exploded", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		if got := IsValidWebhookEvent(tc.event); got != tc.want {
+			t.Errorf("IsValidWebhookEvent(%q) = %v; want %v", tc.event, got, tc.want)
+		}
+	}
+}
+
+// TestBuildWebhookPayload_CurrentAndPrevious wraps the resource in current/previous
+func TestBuildWebhookPayload_CurrentAndPrevious(t *testing.T) {
+	post := &Post{ID: "post-1", Title: "Hello", Status: "published"}
+
+	// Published: resource is sent as current
+	payload, err := BuildWebhookPayload("post.published", post)
+	if err != nil {
+		t.Fatalf("Failed to build payload: %v", err)
+	}
+	wrapper, ok := payload["post"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("payload does not contain post: %v", payload)
+	}
+	current := wrapper["current"].(map[string]interface{})
+	if current["title"] != "Hello" {
+		t.Errorf("current.title = %v; want %q", current["title"], "Hello")
+	}
+	if len(wrapper["previous"].(map[string]interface{})) != 0 {
+		t.Errorf("previous = %v; want empty", wrapper["previous"])
+	}
+
+	// Deleted: resource is sent as previous
+	payload, err = BuildWebhookPayload("post.deleted", post)
+	if err != nil {
+		t.Fatalf("Failed to build payload: %v", err)
+	}
+	wrapper = payload["post"].(map[string]interface{})
+	if len(wrapper["current"].(map[string]interface{})) != 0 {
+		t.Errorf("current = %v; want empty", wrapper["current"])
+	}
+	if wrapper["previous"].(map[string]interface{})["id"] != "post-1" {
+		t.Errorf("previous.id = %v; want %q", wrapper["previous"], "post-1")
+	}
+
+	// Unknown events are rejected
+	if _, err := BuildWebhookPayload("post.exploded", post); err == nil {
+		t.Error("expected error for unknown event")
+	}
+}
+
+// TestSignWebhookPayload_MatchesGhostScheme verifies the signature format
+func TestSignWebhookPayload_MatchesGhostScheme(t *testing.T) {
+	body := []byte(`{"post":{}}`)
+	ts := time.UnixMilli(1700000000123)
+
+	got := SignWebhookPayload(body, "s3cret", ts)
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(`{"post":{}}1700000000123`))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil)) + ", t=1700000000123"
+
+	if got != want {
+		t.Errorf("SignWebhookPayload() = %q; want %q", got, want)
+	}
+}
+
+// TestSendWebhook_SignedDelivery delivers a signed fixture payload
+func TestSendWebhook_SignedDelivery(t *testing.T) {
+	// Create test HTTP server that verifies the signature
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("HTTP method = %q; want %q", r.Method, "POST")
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		header := r.Header.Get(WebhookSignatureHeader)
+		parts := strings.Split(header, ", t=")
+		if len(parts) != 2 {
+			t.Fatalf("%s = %q; want sha256=<hex>, t=<ts>", WebhookSignatureHeader, header)
+		}
+
+		mac := hmac.New(sha256.New, []byte("s3cret"))
+		mac.Write(body)
+		mac.Write([]byte(parts[1]))
+		if parts[0] != "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+			t.Errorf("signature does not match body")
+		}
+
+		var payload map[string]interface{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("request body parse error: %v", err)
+		}
+		if _, ok := payload["member"]; !ok {
+			t.Errorf("payload does not contain member: %s", body)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	payload, err := BuildWebhookPayload("member.added", WebhookFixture("member.added"))
+	if err != nil {
+		t.Fatalf("Failed to build payload: %v", err)
+	}
+
+	delivery, err := SendWebhook(server.URL, "s3cret", "member.added", payload)
+	if err != nil {
+		t.Fatalf("Failed to send webhook: %v", err)
+	}
+	if delivery.StatusCode != http.StatusAccepted {
+		t.Errorf("StatusCode = %d; want %d", delivery.StatusCode, http.StatusAccepted)
+	}
+	if delivery.Signature == "" {
+		t.Error("Signature is empty")
+	}
+}
+
+// TestSendWebhook_UnsignedWithoutSecret omits the signature header without a secret
+func TestSendWebhook_UnsignedWithoutSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(WebhookSignatureHeader) != "" {
+			t.Errorf("%s should not be set without a secret", WebhookSignatureHeader)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	delivery, err := SendWebhook(server.URL, "", "site.changed", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Failed to send webhook: %v", err)
+	}
+	if delivery.Signature != "" {
+		t.Errorf("Signature = %q; want empty", delivery.Signature)
+	}
+}
@@ -0,0 +1,133 @@
+/**
+ * imageproc.go
+ * Local image pre-processing
+ *
+ * Resizes, recompresses, and strips metadata (EXIF, GPS, XMP, text chunks)
+ * from JPEG and PNG images before upload, using only the standard library.
+ * Other formats (GIF, WebP, SVG, ...) are passed through unchanged.
+ */
+
+package imageproc
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+)
+
+// Options controls pre-processing (the zero value changes nothing)
+type Options struct {
+	MaxWidth      int  // Downscale images wider than this (0: keep size)
+	Recompress    bool // Re-encode JPEG/PNG even when not resized
+	Quality       int  // JPEG quality when re-encoding (default: 85)
+	StripMetadata bool // Remove EXIF/GPS/XMP and text metadata
+}
+
+// DefaultQuality is the JPEG quality used when Options.Quality is unset
+const DefaultQuality = 85
+
+// Format is an image format handled by this package
+type Format string
+
+const (
+	FormatJPEG  Format = "jpeg"
+	FormatPNG   Format = "png"
+	FormatOther Format = ""
+)
+
+// DetectFormat detects JPEG and PNG from the file signature
+func DetectFormat(data []byte) Format {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return FormatJPEG
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG
+	default:
+		return FormatOther
+	}
+}
+
+// Process applies the options to an image and returns the new content
+//
+// Re-encoding always drops metadata. When only StripMetadata is set, metadata
+// is removed without re-encoding, unless a JPEG's EXIF orientation has to be
+// applied to the pixels first so the image does not appear rotated.
+func Process(data []byte, opts Options) ([]byte, error) {
+	format := DetectFormat(data)
+	if format == FormatOther {
+		return data, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	orientation := 1
+	if format == FormatJPEG {
+		orientation = jpegOrientation(data)
+	}
+
+	resize := opts.MaxWidth > 0 && orientedWidth(config, orientation) > opts.MaxWidth
+	reencode := resize || opts.Recompress || (opts.StripMetadata && orientation != 1)
+
+	if !reencode {
+		if !opts.StripMetadata {
+			return data, nil
+		}
+		if format == FormatJPEG {
+			return stripJPEG(data)
+		}
+		return stripPNG(data)
+	}
+
+	// Decode, orient, and resize
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	img = applyOrientation(img, orientation)
+	if resize {
+		img = Resize(img, opts.MaxWidth)
+	}
+
+	// Encode in the original format
+	var buf bytes.Buffer
+	switch format {
+	case FormatJPEG:
+		quality := opts.Quality
+		if quality <= 0 {
+			quality = DefaultQuality
+		}
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	case FormatPNG:
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		err = encoder.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	// Keep the original if recompression made a same-size image larger
+	if !resize && orientation == 1 && buf.Len() >= len(data) {
+		if opts.StripMetadata {
+			if format == FormatJPEG {
+				return stripJPEG(data)
+			}
+			return stripPNG(data)
+		}
+		return data, nil
+	}
+
+	return buf.Bytes(), nil
+}
+
+// orientedWidth returns the displayed width after EXIF orientation
+func orientedWidth(config image.Config, orientation int) int {
+	if orientation >= 5 && orientation <= 8 {
+		return config.Height
+	}
+	return config.Width
+}
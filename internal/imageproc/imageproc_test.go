@@ -0,0 +1,152 @@
+/**
+ * imageproc_test.go
+ * Test code for local image pre-processing
+ */
+
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// testImage creates a w x h image with a red left half and blue right half
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+// exifSegment builds an APP1 EXIF segment with an orientation tag
+func exifSegment(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	ifd := make([]byte, 2+12+4)
+	binary.BigEndian.PutUint16(ifd[0:], 1)      // One entry
+	binary.BigEndian.PutUint16(ifd[2:], 0x0112) // Orientation
+	binary.BigEndian.PutUint16(ifd[4:], 3)      // SHORT
+	binary.BigEndian.PutUint32(ifd[6:], 1)      // Count
+	binary.BigEndian.PutUint16(ifd[10:], orientation)
+	payload := append([]byte("Exif\x00\x00"), append(tiff, ifd...)...)
+
+	segment := []byte{0xFF, markerAPP1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// encodeJPEG encodes an image and inserts an EXIF segment after SOI
+func encodeJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+		t.Fatalf("jpeg.Encode() error: %v", err)
+	}
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), exifSegment(orientation)...), data[2:]...)
+}
+
+// TestProcess_StripJPEGLossless tests removing EXIF without re-encoding
+func TestProcess_StripJPEGLossless(t *testing.T) {
+	data := encodeJPEG(t, testImage(40, 20), 1)
+
+	out, err := Process(data, Options{StripMetadata: true})
+	if err != nil {
+		t.Fatalf("Process() error: %v", err)
+	}
+	if bytes.Contains(out, []byte("Exif")) {
+		t.Error("EXIF segment was not removed")
+	}
+	if len(data)-len(out) != len(exifSegment(1)) {
+		t.Errorf("removed %d bytes; want only the EXIF segment (%d)", len(data)-len(out), len(exifSegment(1)))
+	}
+}
+
+// TestProcess_AppliesOrientationWhenStripping tests that stripped images keep their displayed rotation
+func TestProcess_AppliesOrientationWhenStripping(t *testing.T) {
+	data := encodeJPEG(t, testImage(40, 20), 6)
+	if jpegOrientation(data) != 6 {
+		t.Fatalf("jpegOrientation() = %d; want 6", jpegOrientation(data))
+	}
+
+	out, err := Process(data, Options{StripMetadata: true})
+	if err != nil {
+		t.Fatalf("Process() error: %v", err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(out))
+	if err != nil {
+		t.Fatalf("jpeg.Decode() error: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 20 || b.Dy() != 40 {
+		t.Errorf("size = %dx%d; want 20x40 (rotated)", b.Dx(), b.Dy())
+	}
+	// Rotating 90 CW moves the red left half to the top
+	if r, _, b, _ := img.At(10, 5).RGBA(); r < b {
+		t.Error("top of rotated image is not red")
+	}
+}
+
+// TestProcess_ResizePNG tests downscaling to a maximum width
+func TestProcess_ResizePNG(t *testing.T) {
+	var buf bytes.Buffer
+	png.Encode(&buf, testImage(400, 100))
+
+	out, err := Process(buf.Bytes(), Options{MaxWidth: 100})
+	if err != nil {
+		t.Fatalf("Process() error: %v", err)
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(out))
+	if err != nil {
+		t.Fatalf("DecodeConfig() error: %v", err)
+	}
+	if format != "png" || config.Width != 100 || config.Height != 25 {
+		t.Errorf("result = %s %dx%d; want png 100x25", format, config.Width, config.Height)
+	}
+}
+
+// TestProcess_NoOptionsKeepsBytes tests that the zero options change nothing
+func TestProcess_NoOptionsKeepsBytes(t *testing.T) {
+	data := encodeJPEG(t, testImage(40, 20), 1)
+	out, err := Process(data, Options{MaxWidth: 1000})
+	if err != nil {
+		t.Fatalf("Process() error: %v", err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Error("image was modified")
+	}
+
+	gif := []byte("GIF89a...")
+	if out, _ := Process(gif, Options{MaxWidth: 10, StripMetadata: true}); !bytes.Equal(out, gif) {
+		t.Error("GIF was modified")
+	}
+}
+
+// TestStripPNG_RemovesTextChunks tests removing PNG metadata chunks
+func TestStripPNG_RemovesTextChunks(t *testing.T) {
+	var buf bytes.Buffer
+	png.Encode(&buf, testImage(4, 4))
+	data := buf.Bytes()
+
+	// Insert a tEXt chunk after IHDR (8-byte signature + 25-byte IHDR chunk)
+	text := []byte("\x00\x00\x00\x07tEXtGPS\x00abc\x00\x00\x00\x00")
+	withText := append(append(append([]byte{}, data[:33]...), text...), data[33:]...)
+
+	out, err := stripPNG(withText)
+	if err != nil {
+		t.Fatalf("stripPNG() error: %v", err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Error("tEXt chunk was not removed exactly")
+	}
+}
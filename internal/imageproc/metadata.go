@@ -0,0 +1,170 @@
+/**
+ * metadata.go
+ * Lossless metadata removal
+ *
+ * Removes metadata segments from JPEG files (APP1 EXIF/XMP, APP13 IPTC,
+ * comments) and metadata chunks from PNG files without touching pixel data,
+ * and reads the EXIF orientation tag.
+ */
+
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// JPEG markers
+const (
+	markerSOS  = 0xDA
+	markerAPP1 = 0xE1
+	markerAPPD = 0xED
+	markerCOM  = 0xFE
+)
+
+// pngMetadataChunks are PNG chunks that carry metadata rather than pixels
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"iTXt": true,
+	"zTXt": true,
+	"tIME": true,
+}
+
+// jpegSegment is a marker segment before the image data
+type jpegSegment struct {
+	marker byte
+	data   []byte // Segment payload (after the length field)
+	raw    []byte // Whole segment including marker and length
+}
+
+// jpegSegments splits a JPEG into its header segments and the remaining scan data
+func jpegSegments(data []byte) ([]jpegSegment, []byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, nil, fmt.Errorf("not a JPEG file")
+	}
+
+	segments := []jpegSegment{}
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, nil, fmt.Errorf("invalid JPEG marker at offset %d", pos)
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			// Fill byte
+			pos++
+			continue
+		}
+		if marker == markerSOS {
+			return segments, data[pos:], nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, nil, fmt.Errorf("truncated JPEG segment at offset %d", pos)
+		}
+		segments = append(segments, jpegSegment{marker: marker, data: data[pos+4 : end], raw: data[pos:end]})
+		pos = end
+	}
+	return nil, nil, fmt.Errorf("JPEG has no image data")
+}
+
+// stripJPEG removes EXIF/XMP (APP1), IPTC (APP13), and comment segments
+func stripJPEG(data []byte) ([]byte, error) {
+	segments, rest, err := jpegSegments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:2])
+	for _, segment := range segments {
+		if segment.marker == markerAPP1 || segment.marker == markerAPPD || segment.marker == markerCOM {
+			continue
+		}
+		buf.Write(segment.raw)
+	}
+	buf.Write(rest)
+	return buf.Bytes(), nil
+}
+
+// stripPNG removes metadata chunks
+func stripPNG(data []byte) ([]byte, error) {
+	const signatureLen = 8
+	if len(data) < signatureLen {
+		return nil, fmt.Errorf("not a PNG file")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:signatureLen])
+	pos := signatureLen
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, fmt.Errorf("truncated PNG chunk at offset %d", pos)
+		}
+		if !pngMetadataChunks[string(data[pos+4:pos+8])] {
+			buf.Write(data[pos:end])
+		}
+		pos = end
+	}
+	return buf.Bytes(), nil
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG (1 if absent)
+func jpegOrientation(data []byte) int {
+	segments, _, err := jpegSegments(data)
+	if err != nil {
+		return 1
+	}
+
+	for _, segment := range segments {
+		if segment.marker != markerAPP1 || !bytes.HasPrefix(segment.data, []byte("Exif\x00\x00")) {
+			continue
+		}
+		if orientation := exifOrientation(segment.data[6:]); orientation != 0 {
+			return orientation
+		}
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation tag (0x0112) from IFD0 of a TIFF block
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			value := int(order.Uint16(tiff[entry+8:]))
+			if value >= 1 && value <= 8 {
+				return value
+			}
+			return 0
+		}
+	}
+	return 0
+}
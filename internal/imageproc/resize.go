@@ -0,0 +1,101 @@
+/**
+ * resize.go
+ * Image resizing and orientation
+ *
+ * Downscales by area averaging, which gives smooth results for the large
+ * reductions typical of camera images without any external packages.
+ */
+
+package imageproc
+
+import (
+	"image"
+	"image/draw"
+)
+
+// Resize scales an image down to maxWidth, keeping the aspect ratio
+//
+// Images that are already narrow enough are returned unchanged.
+func Resize(src image.Image, maxWidth int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if maxWidth <= 0 || srcW <= maxWidth {
+		return src
+	}
+	dstW := maxWidth
+	dstH := max(1, (srcH*dstW+srcW/2)/srcW)
+
+	// Work on premultiplied RGBA for correct averaging of transparent pixels
+	rgba := image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0 := y * srcH / dstH
+		y1 := max(y0+1, (y+1)*srcH/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := x * srcW / dstW
+			x1 := max(x0+1, (x+1)*srcW/dstW)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8((r + n/2) / n)
+			dst.Pix[i+1] = uint8((g + n/2) / n)
+			dst.Pix[i+2] = uint8((b + n/2) / n)
+			dst.Pix[i+3] = uint8((a + n/2) / n)
+		}
+	}
+
+	return dst
+}
+
+// applyOrientation rotates/flips an image according to an EXIF orientation (1-8)
+func applyOrientation(src image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // Mirror horizontal
+				dx, dy = w-1-x, y
+			case 3: // Rotate 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // Mirror vertical
+				dx, dy = x, h-1-y
+			case 5: // Mirror horizontal and rotate 270 CW
+				dx, dy = y, x
+			case 6: // Rotate 90 CW
+				dx, dy = h-1-y, x
+			case 7: // Mirror horizontal and rotate 90 CW
+				dx, dy = h-1-y, w-1-x
+			case 8: // Rotate 270 CW
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, src.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -87,7 +87,7 @@ func DetectFormat(filePath string) ContentFormat {
 //   - inlineContent: inline content
 //
 // Returns:
-//   - content: content string
+//   - content: content string (without front matter)
 //   - format: detected format (FormatUnknown for inline content)
 //   - error: error
 //
@@ -95,20 +95,38 @@ func DetectFormat(filePath string) ContentFormat {
 //  1. If filePath is specified, read from file and detect format
 //  2. If filePath is empty, return inlineContent with FormatUnknown
 func ReadContentWithFormat(filePath string, inlineContent string) (content string, format ContentFormat, err error) {
-	// If filePath is specified
-	if filePath != "" {
-		// Detect format
-		format = DetectFormat(filePath)
+	content, format, _, err = ReadContentWithFrontMatter(filePath, inlineContent)
+	return content, format, err
+}
 
-		// Read from file
-		data, err := os.ReadFile(filePath)
-		if err != nil {
-			return "", FormatUnknown, fmt.Errorf("failed to read file: %w", err)
-		}
+// ReadContentWithFrontMatter reads file or inline content, separating YAML front matter
+//
+// Front matter is only recognized in files that are not Lexical JSON.
+// The returned front matter is nil if the content has none.
+func ReadContentWithFrontMatter(filePath string, inlineContent string) (content string, format ContentFormat, fm *FrontMatter, err error) {
+	// Return inline content (format is unknown)
+	if filePath == "" {
+		return inlineContent, FormatUnknown, nil, nil
+	}
 
-		return string(data), format, nil
+	// Detect format
+	format = DetectFormat(filePath)
+
+	// Read from file
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", FormatUnknown, nil, fmt.Errorf("failed to read file: %w", err)
 	}
+	content = string(data)
 
-	// Return inline content (format is unknown)
-	return inlineContent, FormatUnknown, nil
+	// Lexical JSON has no front matter
+	if format == FormatLexical {
+		return content, format, nil, nil
+	}
+
+	fm, content, err = ParseFrontMatter(content)
+	if err != nil {
+		return "", FormatUnknown, nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+	return content, format, fm, nil
 }
@@ -0,0 +1,208 @@
+/**
+ * frontmatter.go
+ * YAML front matter parsing
+ *
+ * Extracts a leading "---" delimited YAML block from Markdown and HTML
+ * content files and decodes it into post/page metadata.
+ */
+
+package input
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontMatter is the metadata block at the top of a content file
+type FrontMatter struct {
+	// Sync bookkeeping (written by "sync pull", ignored by create/update)
+	ID        string `yaml:"id,omitempty"`
+	UpdatedAt string `yaml:"updated_at,omitempty"`
+
+	Title  string `yaml:"title,omitempty"`
+	Slug   string `yaml:"slug,omitempty"`
+	Status string `yaml:"status,omitempty"`
+
+	Excerpt       string `yaml:"excerpt,omitempty"`
+	CustomExcerpt string `yaml:"custom_excerpt,omitempty"`
+
+	FeatureImage        string `yaml:"feature_image,omitempty"`
+	FeatureImageAlt     string `yaml:"feature_image_alt,omitempty"`
+	FeatureImageCaption string `yaml:"feature_image_caption,omitempty"`
+
+	PublishedAt string `yaml:"published_at,omitempty"`
+	Date        string `yaml:"date,omitempty"` // Alias for published_at (Hugo/Jekyll style)
+
+	Tags    StringList `yaml:"tags,omitempty"`
+	Authors StringList `yaml:"authors,omitempty"` // Emails or slugs
+
+	Featured   *bool  `yaml:"featured,omitempty"`
+	Visibility string `yaml:"visibility,omitempty"`
+
+	MetaTitle          string `yaml:"meta_title,omitempty"`
+	MetaDescription    string `yaml:"meta_description,omitempty"`
+	OGImage            string `yaml:"og_image,omitempty"`
+	OGTitle            string `yaml:"og_title,omitempty"`
+	OGDescription      string `yaml:"og_description,omitempty"`
+	TwitterImage       string `yaml:"twitter_image,omitempty"`
+	TwitterTitle       string `yaml:"twitter_title,omitempty"`
+	TwitterDescription string `yaml:"twitter_description,omitempty"`
+	CanonicalURL       string `yaml:"canonical_url,omitempty"`
+
+	CustomTemplate    string `yaml:"custom_template,omitempty"`
+	CodeinjectionHead string `yaml:"codeinjection_head,omitempty"`
+	CodeinjectionFoot string `yaml:"codeinjection_foot,omitempty"`
+
+	Markdown map[string]bool `yaml:"markdown,omitempty"` // Markdown option overrides for this file
+}
+
+// StringList is a list of strings written either as a YAML sequence or a comma-separated string
+type StringList []string
+
+// UnmarshalYAML accepts both "a, b" and [a, b]
+func (l *StringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = SplitList(value.Value)
+		return nil
+	}
+
+	var items []string
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*l = nil
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// SplitList splits a comma-separated string, dropping empty items
+func SplitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// SplitFrontMatter separates a leading "---" delimited block from the body
+//
+// Returns the raw YAML block (nil if there is none) and the remaining body.
+func SplitFrontMatter(content string) (block []byte, body string) {
+	// Tolerate a UTF-8 BOM and Windows line endings
+	rest := strings.TrimPrefix(content, "\ufeff")
+	firstLine, after, found := strings.Cut(rest, "\n")
+	if !found || strings.TrimRight(firstLine, "\r ") != "---" {
+		return nil, content
+	}
+
+	// Find the closing delimiter on its own line
+	offset := 0
+	for {
+		line, next, more := strings.Cut(after[offset:], "\n")
+		if trimmed := strings.TrimRight(line, "\r "); trimmed == "---" || trimmed == "..." {
+			block = []byte(after[:offset])
+			if more {
+				body = next
+			}
+			return block, strings.TrimLeft(body, "\r\n")
+		}
+		if !more {
+			return nil, content
+		}
+		offset += len(line) + 1
+	}
+}
+
+// ParseFrontMatter decodes the front matter of content
+//
+// Returns nil front matter when the content has none. Unknown keys are
+// rejected so that typos do not go unnoticed.
+func ParseFrontMatter(content string) (*FrontMatter, string, error) {
+	block, body := SplitFrontMatter(content)
+	if block == nil {
+		return nil, content, nil
+	}
+
+	fm := &FrontMatter{}
+	decoder := yaml.NewDecoder(bytes.NewReader(block))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(fm); err != nil && !errors.Is(err, io.EOF) {
+		return nil, "", fmt.Errorf("failed to parse front matter: %w", err)
+	}
+
+	return fm, body, nil
+}
+
+// FormatFrontMatter renders front matter as a "---" delimited YAML block
+//
+// Empty fields are omitted, so the output can be fed back to ParseFrontMatter.
+func FormatFrontMatter(fm *FrontMatter) (string, error) {
+	data, err := yaml.Marshal(fm)
+	if err != nil {
+		return "", fmt.Errorf("failed to format front matter: %w", err)
+	}
+	return "---\n" + string(data) + "---\n", nil
+}
+
+// ExcerptValue returns the custom excerpt from either excerpt key
+func (fm *FrontMatter) ExcerptValue() string {
+	if fm.CustomExcerpt != "" {
+		return fm.CustomExcerpt
+	}
+	return fm.Excerpt
+}
+
+// PublishedTime parses published_at (or date)
+//
+// Returns nil if neither key is set. Dates without a time zone are
+// interpreted in the local time zone.
+func (fm *FrontMatter) PublishedTime() (*time.Time, error) {
+	value := fm.PublishedAt
+	if value == "" {
+		value = fm.Date
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := ParseTime(value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// timeLayouts are the date formats accepted for publish dates
+var timeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses an RFC 3339 timestamp or a local date/time
+func ParseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD, YYYY-MM-DD HH:MM, or RFC 3339)", value)
+}
@@ -0,0 +1,151 @@
+/**
+ * frontmatter_test.go
+ * Test code for YAML front matter parsing
+ */
+
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestParseFrontMatter_Fields verifies decoding of the supported keys
+func TestParseFrontMatter_Fields(t *testing.T) {
+	content := "---\n" +
+		"title: Hello World\n" +
+		"slug: hello\n" +
+		"tags: [News, Go]\n" +
+		"authors: jane@example.com, bob\n" +
+		"featured: true\n" +
+		"date: 2024-03-01\n" +
+		"excerpt: Short\n" +
+		"---\n\n# Body\n"
+
+	fm, body, err := ParseFrontMatter(content)
+	if err != nil {
+		t.Fatalf("ParseFrontMatter() error = %v", err)
+	}
+	if body != "# Body\n" {
+		t.Errorf("body = %q; want %q", body, "# Body\n")
+	}
+	if fm.Title != "Hello World" || fm.Slug != "hello" || fm.ExcerptValue() != "Short" {
+		t.Errorf("fm = %+v", fm)
+	}
+	if !reflect.DeepEqual([]string(fm.Tags), []string{"News", "Go"}) {
+		t.Errorf("Tags = %v", fm.Tags)
+	}
+	if !reflect.DeepEqual([]string(fm.Authors), []string{"jane@example.com", "bob"}) {
+		t.Errorf("Authors = %v", fm.Authors)
+	}
+	if fm.Featured == nil || !*fm.Featured {
+		t.Errorf("Featured = %v; want true", fm.Featured)
+	}
+
+	published, err := fm.PublishedTime()
+	if err != nil {
+		t.Fatalf("PublishedTime() error = %v", err)
+	}
+	want := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	if published == nil || !published.Equal(want) {
+		t.Errorf("PublishedTime() = %v; want %v", published, want)
+	}
+}
+
+// TestParseFrontMatter_None verifies that content without front matter is unchanged
+func TestParseFrontMatter_None(t *testing.T) {
+	for _, content := range []string{"# Title\n", "---\nno closing delimiter\n", "text\n---\ntitle: x\n---\n"} {
+		fm, body, err := ParseFrontMatter(content)
+		if err != nil || fm != nil || body != content {
+			t.Errorf("ParseFrontMatter(%q) = %v, %q, %v; want nil, unchanged, nil", content, fm, body, err)
+		}
+	}
+}
+
+// TestParseFrontMatter_UnknownKey verifies that typos are reported
+func TestParseFrontMatter_UnknownKey(t *testing.T) {
+	if _, _, err := ParseFrontMatter("---\ntitel: Oops\n---\nbody"); err == nil {
+		t.Error("ParseFrontMatter() error = nil; want error for unknown key")
+	}
+}
+
+// TestParseFrontMatter_CRLF verifies Windows line endings
+func TestParseFrontMatter_CRLF(t *testing.T) {
+	fm, body, err := ParseFrontMatter("---\r\ntitle: Hi\r\n---\r\nbody\r\n")
+	if err != nil {
+		t.Fatalf("ParseFrontMatter() error = %v", err)
+	}
+	if fm == nil || fm.Title != "Hi" || body != "body\r\n" {
+		t.Errorf("ParseFrontMatter() = %+v, %q", fm, body)
+	}
+}
+
+// TestParseTime verifies the accepted date formats
+func TestParseTime(t *testing.T) {
+	for _, value := range []string{"2024-03-01T10:00:00Z", "2024-03-01T10:00:00+09:00", "2024-03-01 10:00", "2024-03-01"} {
+		if _, err := ParseTime(value); err != nil {
+			t.Errorf("ParseTime(%q) error = %v", value, err)
+		}
+	}
+	if _, err := ParseTime("March 1st"); err == nil {
+		t.Error("ParseTime() error = nil; want error")
+	}
+}
+
+// TestReadContentWithFrontMatter verifies that files are split into front matter and body
+func TestReadContentWithFrontMatter(t *testing.T) {
+	tmpDir := t.TempDir()
+	mdFile := filepath.Join(tmpDir, "post.md")
+	if err := os.WriteFile(mdFile, []byte("---\ntitle: From File\n---\n# Body\n"), 0644); err != nil {
+		t.Fatalf("Failed to create temporary file: %v", err)
+	}
+
+	content, format, fm, err := ReadContentWithFrontMatter(mdFile, "")
+	if err != nil {
+		t.Fatalf("ReadContentWithFrontMatter() error = %v", err)
+	}
+	if content != "# Body\n" || format != FormatMarkdown || fm == nil || fm.Title != "From File" {
+		t.Errorf("ReadContentWithFrontMatter() = %q, %q, %+v", content, format, fm)
+	}
+
+	// ReadContentWithFormat strips the front matter too
+	content, _, err = ReadContentWithFormat(mdFile, "")
+	if err != nil || content != "# Body\n" {
+		t.Errorf("ReadContentWithFormat() = %q, %v", content, err)
+	}
+
+	// Lexical JSON is never treated as front matter
+	jsonFile := filepath.Join(tmpDir, "post.json")
+	if err := os.WriteFile(jsonFile, []byte("---\ntitle: x\n---\n"), 0644); err != nil {
+		t.Fatalf("Failed to create temporary file: %v", err)
+	}
+	_, _, fm, err = ReadContentWithFrontMatter(jsonFile, "")
+	if err != nil || fm != nil {
+		t.Errorf("ReadContentWithFrontMatter(json) fm = %+v, err = %v; want nil", fm, err)
+	}
+}
+
+// TestFormatFrontMatter_RoundTrip verifies that formatted front matter parses back
+func TestFormatFrontMatter_RoundTrip(t *testing.T) {
+	featured := true
+	fm := &FrontMatter{Title: "Hello: World", Tags: StringList{"go", "cli"}, Featured: &featured}
+
+	formatted, err := FormatFrontMatter(fm)
+	if err != nil {
+		t.Fatalf("FormatFrontMatter() error = %v", err)
+	}
+	if want := "---\ntitle: 'Hello: World'\ntags:\n    - go\n    - cli\nfeatured: true\n---\n"; formatted != want {
+		t.Errorf("FormatFrontMatter() = %q; want %q", formatted, want)
+	}
+
+	parsed, body, err := ParseFrontMatter(formatted + "Body\n")
+	if err != nil {
+		t.Fatalf("ParseFrontMatter() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed, fm) || body != "Body\n" {
+		t.Errorf("ParseFrontMatter() = %+v, %q", parsed, body)
+	}
+}
@@ -0,0 +1,272 @@
+/**
+ * html.go
+ * Lexical to HTML rendering
+ *
+ * Renders Lexical documents to HTML locally, following the markup Ghost's
+ * renderer produces for common nodes and cards. Used for content that only
+ * exists as Lexical (e.g. post revisions).
+ */
+
+package lexical
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/yuin/goldmark"
+)
+
+// RenderHTML renders a Lexical document to HTML
+func RenderHTML(doc *Document) string {
+	var b strings.Builder
+	for _, child := range doc.Root.Children() {
+		renderBlockHTML(&b, child)
+	}
+	return b.String()
+}
+
+// RenderHTMLString parses a Lexical JSON string and renders it to HTML
+func RenderHTMLString(s string) (string, error) {
+	doc, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return RenderHTML(doc), nil
+}
+
+// renderBlockHTML renders a block-level node
+func renderBlockHTML(b *strings.Builder, n Node) {
+	switch n.Type() {
+	case "paragraph":
+		inner := renderInlineHTML(n.Children())
+		if inner == "" {
+			return
+		}
+		fmt.Fprintf(b, "<p>%s</p>", inner)
+	case "heading", "extended-heading":
+		tag := n.String("tag")
+		if tag == "" {
+			tag = "h2"
+		}
+		fmt.Fprintf(b, "<%s>%s</%s>", tag, renderInlineHTML(n.Children()), tag)
+	case "quote", "extended-quote":
+		fmt.Fprintf(b, "<blockquote>%s</blockquote>", renderInlineHTML(n.Children()))
+	case "aside":
+		fmt.Fprintf(b, "<blockquote class=\"kg-blockquote-alt\">%s</blockquote>", renderInlineHTML(n.Children()))
+	case "list":
+		renderListHTML(b, n)
+	case "horizontalrule":
+		b.WriteString("<hr>")
+	case "codeblock":
+		lang := n.String("language")
+		if lang != "" {
+			fmt.Fprintf(b, "<pre><code class=\"language-%s\">%s</code></pre>", html.EscapeString(lang), html.EscapeString(n.String("code")))
+		} else {
+			fmt.Fprintf(b, "<pre><code>%s</code></pre>", html.EscapeString(n.String("code")))
+		}
+	case "image":
+		b.WriteString("<figure class=\"kg-card kg-image-card\">")
+		img := fmt.Sprintf("<img src=\"%s\" class=\"kg-image\" alt=\"%s\" loading=\"lazy\">", html.EscapeString(n.String("src")), html.EscapeString(n.String("alt")))
+		if href := n.String("href"); href != "" {
+			img = fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(href), img)
+		}
+		b.WriteString(img)
+		writeCaption(b, n.String("caption"))
+		b.WriteString("</figure>")
+	case "gallery":
+		b.WriteString("<figure class=\"kg-card kg-gallery-card\"><div class=\"kg-gallery-container\">")
+		if images, ok := n["images"].([]interface{}); ok {
+			for _, raw := range images {
+				if img, ok := raw.(map[string]interface{}); ok {
+					fmt.Fprintf(b, "<div class=\"kg-gallery-image\"><img src=\"%s\" alt=\"%s\" loading=\"lazy\"></div>",
+						html.EscapeString(Node(img).String("src")), html.EscapeString(Node(img).String("alt")))
+				}
+			}
+		}
+		b.WriteString("</div>")
+		writeCaption(b, n.String("caption"))
+		b.WriteString("</figure>")
+	case "html":
+		b.WriteString(n.String("html"))
+	case "markdown":
+		var buf bytes.Buffer
+		if err := goldmark.Convert([]byte(n.String("markdown")), &buf); err == nil {
+			b.WriteString(buf.String())
+		}
+	case "callout":
+		color := n.String("backgroundColor")
+		if color == "" {
+			color = "grey"
+		}
+		fmt.Fprintf(b, "<div class=\"kg-card kg-callout-card kg-callout-card-%s\">", html.EscapeString(color))
+		if emoji := n.String("calloutEmoji"); emoji != "" {
+			fmt.Fprintf(b, "<div class=\"kg-callout-emoji\">%s</div>", emoji)
+		}
+		fmt.Fprintf(b, "<div class=\"kg-callout-text\">%s</div></div>", n.String("calloutText"))
+	case "bookmark":
+		meta := n.Map("metadata")
+		fmt.Fprintf(b, "<figure class=\"kg-card kg-bookmark-card\"><a class=\"kg-bookmark-container\" href=\"%s\"><div class=\"kg-bookmark-content\">", html.EscapeString(n.String("url")))
+		fmt.Fprintf(b, "<div class=\"kg-bookmark-title\">%s</div>", html.EscapeString(meta.String("title")))
+		if desc := meta.String("description"); desc != "" {
+			fmt.Fprintf(b, "<div class=\"kg-bookmark-description\">%s</div>", html.EscapeString(desc))
+		}
+		b.WriteString("</div></a>")
+		writeCaption(b, n.String("caption"))
+		b.WriteString("</figure>")
+	case "embed":
+		b.WriteString("<figure class=\"kg-card kg-embed-card\">")
+		b.WriteString(n.String("html"))
+		writeCaption(b, n.String("caption"))
+		b.WriteString("</figure>")
+	case "button":
+		align := n.String("alignment")
+		if align == "" {
+			align = "center"
+		}
+		fmt.Fprintf(b, "<div class=\"kg-card kg-button-card kg-align-%s\"><a href=\"%s\" class=\"kg-btn kg-btn-accent\">%s</a></div>",
+			html.EscapeString(align), html.EscapeString(n.String("buttonUrl")), html.EscapeString(n.String("buttonText")))
+	case "toggle":
+		fmt.Fprintf(b, "<div class=\"kg-card kg-toggle-card\" data-kg-toggle-state=\"close\"><div class=\"kg-toggle-heading\"><h4 class=\"kg-toggle-heading-text\">%s</h4></div><div class=\"kg-toggle-content\">%s</div></div>",
+			n.String("heading"), n.String("content"))
+	case "header":
+		b.WriteString("<div class=\"kg-card kg-header-card\">")
+		if h := n.String("header"); h != "" {
+			fmt.Fprintf(b, "<h2 class=\"kg-header-card-header\">%s</h2>", h)
+		}
+		if sub := n.String("subheader"); sub != "" {
+			fmt.Fprintf(b, "<h3 class=\"kg-header-card-subheader\">%s</h3>", sub)
+		}
+		if n.Bool("buttonEnabled") && n.String("buttonUrl") != "" {
+			fmt.Fprintf(b, "<a href=\"%s\" class=\"kg-header-card-button\">%s</a>", html.EscapeString(n.String("buttonUrl")), html.EscapeString(n.String("buttonText")))
+		}
+		b.WriteString("</div>")
+	case "product":
+		b.WriteString("<div class=\"kg-card kg-product-card\"><div class=\"kg-product-card-container\">")
+		if img := n.String("productImageSrc"); img != "" {
+			fmt.Fprintf(b, "<img src=\"%s\" class=\"kg-product-card-image\" loading=\"lazy\">", html.EscapeString(img))
+		}
+		fmt.Fprintf(b, "<div class=\"kg-product-card-title-container\"><h4 class=\"kg-product-card-title\">%s</h4></div>", n.String("productTitle"))
+		fmt.Fprintf(b, "<div class=\"kg-product-card-description\">%s</div>", n.String("productDescription"))
+		if n.Bool("productButtonEnabled") && n.String("productUrl") != "" {
+			fmt.Fprintf(b, "<a href=\"%s\" class=\"kg-product-card-button kg-product-card-btn-accent\"><span>%s</span></a>", html.EscapeString(n.String("productUrl")), html.EscapeString(n.String("productButton")))
+		}
+		b.WriteString("</div></div>")
+	case "signup":
+		b.WriteString("<div class=\"kg-card kg-signup-card\">")
+		if h := n.String("header"); h != "" {
+			fmt.Fprintf(b, "<h2 class=\"kg-signup-card-heading\">%s</h2>", h)
+		}
+		if sub := n.String("subheader"); sub != "" {
+			fmt.Fprintf(b, "<h3 class=\"kg-signup-card-subheading\">%s</h3>", sub)
+		}
+		fmt.Fprintf(b, "<form class=\"kg-signup-card-form\" data-members-form=\"signup\"><input class=\"kg-signup-card-input\" type=\"email\" placeholder=\"Your email\" required><button class=\"kg-signup-card-button\" type=\"submit\">%s</button></form>",
+			html.EscapeString(n.String("buttonText")))
+		b.WriteString("</div>")
+	case "video":
+		fmt.Fprintf(b, "<figure class=\"kg-card kg-video-card\"><video src=\"%s\" controls></video>", html.EscapeString(n.String("src")))
+		writeCaption(b, n.String("caption"))
+		b.WriteString("</figure>")
+	case "audio":
+		fmt.Fprintf(b, "<div class=\"kg-card kg-audio-card\"><audio src=\"%s\" controls></audio><div class=\"kg-audio-title\">%s</div></div>",
+			html.EscapeString(n.String("src")), html.EscapeString(n.String("title")))
+	case "file":
+		fmt.Fprintf(b, "<div class=\"kg-card kg-file-card\"><a class=\"kg-file-card-container\" href=\"%s\" download><div class=\"kg-file-card-title\">%s</div></a></div>",
+			html.EscapeString(n.String("src")), html.EscapeString(n.String("fileTitle")))
+	case "paywall":
+		b.WriteString("<!--members-only-->")
+	case "email", "email-cta":
+		// Email-only content is not rendered on the web
+	default:
+		// Render children of unknown element nodes, skip unknown cards
+		if children := n.Children(); len(children) > 0 {
+			b.WriteString(renderInlineHTML(children))
+		}
+	}
+}
+
+// renderListHTML renders a list node and its (possibly nested) items
+func renderListHTML(b *strings.Builder, n Node) {
+	tag := n.String("tag")
+	if tag == "" {
+		tag = "ul"
+		if n.String("listType") == "number" {
+			tag = "ol"
+		}
+	}
+	if start := n.Int("start"); tag == "ol" && start > 1 {
+		fmt.Fprintf(b, "<%s start=\"%d\">", tag, start)
+	} else {
+		fmt.Fprintf(b, "<%s>", tag)
+	}
+	for _, item := range n.Children() {
+		b.WriteString("<li>")
+		for _, child := range item.Children() {
+			if child.Type() == "list" {
+				renderListHTML(b, child)
+			} else {
+				b.WriteString(renderInlineHTML([]Node{child}))
+			}
+		}
+		b.WriteString("</li>")
+	}
+	fmt.Fprintf(b, "</%s>", tag)
+}
+
+// renderInlineHTML renders inline nodes (text, links, line breaks)
+func renderInlineHTML(nodes []Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Type() {
+		case "text", "extended-text":
+			b.WriteString(formatTextHTML(html.EscapeString(n.String("text")), n.Int("format")))
+		case "link":
+			fmt.Fprintf(&b, "<a href=\"%s\">%s</a>", html.EscapeString(n.String("url")), renderInlineHTML(n.Children()))
+		case "linebreak":
+			b.WriteString("<br>")
+		case "tab":
+			b.WriteString("\t")
+		default:
+			if children := n.Children(); len(children) > 0 {
+				b.WriteString(renderInlineHTML(children))
+			} else {
+				// Block-level card nested inside an element
+				var inner strings.Builder
+				renderBlockHTML(&inner, n)
+				b.WriteString(inner.String())
+			}
+		}
+	}
+	return b.String()
+}
+
+// formatTextHTML wraps escaped text in tags for each format bit flag
+func formatTextHTML(text string, format int) string {
+	wrappers := []struct {
+		flag int
+		tag  string
+	}{
+		{FormatCode, "code"},
+		{FormatHighlight, "mark"},
+		{FormatSuperscript, "sup"},
+		{FormatSubscript, "sub"},
+		{FormatUnderline, "u"},
+		{FormatStrikethrough, "s"},
+		{FormatItalic, "em"},
+		{FormatBold, "strong"},
+	}
+	for _, w := range wrappers {
+		if format&w.flag != 0 {
+			text = fmt.Sprintf("<%s>%s</%s>", w.tag, text, w.tag)
+		}
+	}
+	return text
+}
+
+// writeCaption writes a figcaption if the caption is non-empty
+func writeCaption(b *strings.Builder, caption string) {
+	if caption != "" {
+		fmt.Fprintf(b, "<figcaption>%s</figcaption>", caption)
+	}
+}
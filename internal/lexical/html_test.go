@@ -0,0 +1,86 @@
+/**
+ * html_test.go
+ * Test code for Lexical to HTML rendering
+ */
+
+package lexical
+
+import (
+	"testing"
+)
+
+// TestRenderHTMLString_BasicNodes tests rendering of paragraphs, headings, and text formats
+func TestRenderHTMLString_BasicNodes(t *testing.T) {
+	input := `{"root":{"type":"root","children":[
+		{"type":"heading","tag":"h2","children":[{"type":"extended-text","text":"Title","format":0}]},
+		{"type":"paragraph","children":[
+			{"type":"extended-text","text":"Hello ","format":0},
+			{"type":"extended-text","text":"bold","format":1},
+			{"type":"extended-text","text":" & ","format":0},
+			{"type":"link","url":"https://example.com","children":[{"type":"extended-text","text":"link","format":2}]}
+		]}
+	]}}`
+
+	got, err := RenderHTMLString(input)
+	if err != nil {
+		t.Fatalf("RenderHTMLString() error: %v", err)
+	}
+
+	want := `<h2>Title</h2><p>Hello <strong>bold</strong> &amp; <a href="https://example.com"><em>link</em></a></p>`
+	if got != want {
+		t.Errorf("RenderHTMLString() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+// TestRenderHTMLString_ListsAndCode tests rendering of nested lists and code blocks
+func TestRenderHTMLString_ListsAndCode(t *testing.T) {
+	input := `{"root":{"type":"root","children":[
+		{"type":"list","listType":"bullet","tag":"ul","children":[
+			{"type":"listitem","children":[
+				{"type":"extended-text","text":"one","format":0},
+				{"type":"list","listType":"number","tag":"ol","children":[
+					{"type":"listitem","children":[{"type":"extended-text","text":"nested","format":0}]}
+				]}
+			]}
+		]},
+		{"type":"codeblock","language":"go","code":"if a < b {}"}
+	]}}`
+
+	got, err := RenderHTMLString(input)
+	if err != nil {
+		t.Fatalf("RenderHTMLString() error: %v", err)
+	}
+
+	want := `<ul><li>one<ol><li>nested</li></ol></li></ul><pre><code class="language-go">if a &lt; b {}</code></pre>`
+	if got != want {
+		t.Errorf("RenderHTMLString() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+// TestRenderHTMLString_Cards tests rendering of image and HTML cards
+func TestRenderHTMLString_Cards(t *testing.T) {
+	input := `{"root":{"type":"root","children":[
+		{"type":"image","src":"https://example.com/a.png","alt":"A","caption":"Chart"},
+		{"type":"html","html":"<div>raw</div>"}
+	]}}`
+
+	got, err := RenderHTMLString(input)
+	if err != nil {
+		t.Fatalf("RenderHTMLString() error: %v", err)
+	}
+
+	want := `<figure class="kg-card kg-image-card"><img src="https://example.com/a.png" class="kg-image" alt="A" loading="lazy"><figcaption>Chart</figcaption></figure><div>raw</div>`
+	if got != want {
+		t.Errorf("RenderHTMLString() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+// TestParse_InvalidJSON tests that invalid documents are rejected
+func TestParse_InvalidJSON(t *testing.T) {
+	if _, err := Parse(`{"root":`); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+	if _, err := Parse(`{}`); err == nil {
+		t.Error("expected error for missing root")
+	}
+}
@@ -0,0 +1,158 @@
+/**
+ * lexical.go
+ * Lexical document model
+ *
+ * Provides a lightweight model for Ghost's Lexical JSON documents.
+ * Nodes are kept as generic maps so that unknown card types round-trip unchanged.
+ */
+
+package lexical
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Text format bit flags used by Lexical text nodes
+const (
+	FormatBold          = 1
+	FormatItalic        = 2
+	FormatStrikethrough = 4
+	FormatUnderline     = 8
+	FormatCode          = 16
+	FormatSubscript     = 32
+	FormatSuperscript   = 64
+	FormatHighlight     = 128
+)
+
+// Node represents a single Lexical node
+type Node map[string]interface{}
+
+// Document represents a Lexical document
+type Document struct {
+	Root Node `json:"root"`
+}
+
+// Parse parses a Lexical JSON string
+func Parse(s string) (*Document, error) {
+	var doc Document
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse Lexical JSON: %w", err)
+	}
+	if doc.Root == nil {
+		return nil, fmt.Errorf("failed to parse Lexical JSON: missing root node")
+	}
+	return &doc, nil
+}
+
+// String serializes the document to a Lexical JSON string
+func (d *Document) String() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode Lexical JSON: %w", err)
+	}
+	return string(data), nil
+}
+
+// NewDocument creates a document with the given top-level nodes
+func NewDocument(children ...Node) *Document {
+	return &Document{Root: Element("root", children...)}
+}
+
+// Element creates an element node (root, paragraph, heading, list, etc.)
+func Element(nodeType string, children ...Node) Node {
+	if children == nil {
+		children = []Node{}
+	}
+	return Node{
+		"type":      nodeType,
+		"version":   1,
+		"children":  children,
+		"direction": "ltr",
+		"format":    "",
+		"indent":    0,
+	}
+}
+
+// Text creates a text node with the given format bit flags
+func Text(text string, format int) Node {
+	return Node{
+		"type":    "extended-text",
+		"version": 1,
+		"text":    text,
+		"format":  format,
+		"detail":  0,
+		"mode":    "normal",
+		"style":   "",
+	}
+}
+
+// Card creates a decorator (card) node with the given fields
+func Card(nodeType string, fields map[string]interface{}) Node {
+	node := Node{
+		"type":    nodeType,
+		"version": 1,
+	}
+	for k, v := range fields {
+		node[k] = v
+	}
+	return node
+}
+
+// Type returns the node type
+func (n Node) Type() string {
+	return n.String("type")
+}
+
+// String returns a string field (empty if missing or not a string)
+func (n Node) String(key string) string {
+	if s, ok := n[key].(string); ok {
+		return s
+	}
+	return ""
+}
+
+// Int returns an integer field (zero if missing or not a number)
+func (n Node) Int(key string) int {
+	switch v := n[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+// Bool returns a boolean field (false if missing or not a boolean)
+func (n Node) Bool(key string) bool {
+	b, _ := n[key].(bool)
+	return b
+}
+
+// Map returns a nested object field as a Node (nil if missing)
+func (n Node) Map(key string) Node {
+	switch v := n[key].(type) {
+	case map[string]interface{}:
+		return Node(v)
+	case Node:
+		return v
+	}
+	return nil
+}
+
+// Children returns the child nodes
+func (n Node) Children() []Node {
+	switch v := n["children"].(type) {
+	case []interface{}:
+		children := make([]Node, 0, len(v))
+		for _, c := range v {
+			if m, ok := c.(map[string]interface{}); ok {
+				children = append(children, Node(m))
+			}
+		}
+		return children
+	case []Node:
+		return v
+	}
+	return nil
+}
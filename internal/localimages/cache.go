@@ -0,0 +1,116 @@
+/**
+ * cache.go
+ * Upload cache for local images
+ *
+ * Remembers which URL each local file was uploaded to (per site) so that
+ * re-running a command does not upload the same image again. Entries are
+ * keyed by absolute path and invalidated when the file content changes.
+ */
+
+package localimages
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CacheEntry is one uploaded file
+type CacheEntry struct {
+	Hash string `json:"hash"` // SHA-256 of the uploaded file
+	URL  string `json:"url"`
+}
+
+// Cache maps local files to uploaded URLs, per site
+type Cache struct {
+	path  string
+	mu    sync.Mutex
+	Sites map[string]map[string]CacheEntry `json:"sites"` // Site URL -> absolute path -> entry
+}
+
+// DefaultCachePath returns the cache file in the user cache directory
+func DefaultCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gho", "image-uploads.json"), nil
+}
+
+// LoadCache reads the cache file (an empty cache if it does not exist)
+func LoadCache(path string) (*Cache, error) {
+	cache := &Cache{path: path, Sites: map[string]map[string]CacheEntry{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload cache: %w", err)
+	}
+	if err := json.Unmarshal(data, cache); err != nil {
+		return nil, fmt.Errorf("failed to parse upload cache: %w", err)
+	}
+	if cache.Sites == nil {
+		cache.Sites = map[string]map[string]CacheEntry{}
+	}
+	return cache, nil
+}
+
+// Lookup returns the URL a file with this content was uploaded to
+func (c *Cache) Lookup(site, path, hash string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.Sites[site][path]
+	if !ok || entry.Hash != hash {
+		return "", false
+	}
+	return entry.URL, true
+}
+
+// Store records an upload
+func (c *Cache) Store(site, path, hash, url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Sites[site] == nil {
+		c.Sites[site] = map[string]CacheEntry{}
+	}
+	c.Sites[site][path] = CacheEntry{Hash: hash, URL: url}
+}
+
+// Save writes the cache file
+func (c *Cache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode upload cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Write atomically so an interrupted run cannot corrupt the cache
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write upload cache: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("failed to write upload cache: %w", err)
+	}
+	return nil
+}
+
+// HashData returns the hex SHA-256 of data
+func HashData(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
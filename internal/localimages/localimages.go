@@ -0,0 +1,213 @@
+/**
+ * localimages.go
+ * Local image references in Markdown and HTML content
+ *
+ * Finds images that point at files on disk (e.g. ![](./img/chart.png) or
+ * <img src="cover.jpg">), hands each file to an upload function, and
+ * rewrites the references to the returned URLs.
+ */
+
+package localimages
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/mtane0412/ghocli/internal/filetype"
+)
+
+// UploadFunc uploads the image at an absolute path and returns its URL
+type UploadFunc func(path string) (string, error)
+
+// Rewriter replaces local image references with uploaded URLs
+type Rewriter struct {
+	BaseDir string     // Directory relative references are resolved against
+	Upload  UploadFunc // Called once per distinct file
+
+	urls map[string]string // Absolute path -> URL for this run
+}
+
+var (
+	// Markdown inline images: ![alt](dest "title")
+	markdownImagePattern = regexp.MustCompile(`(!\[(?:[^\]\\]|\\.)*\]\(\s*)(<[^>\n]*>|[^\s)]+)`)
+	// Markdown reference definitions: [id]: dest "title"
+	markdownRefPattern = regexp.MustCompile(`(?m)^( {0,3}\[[^\]]+\]:[ \t]*)(<[^>\n]*>|\S+)`)
+	// HTML <img src="...">
+	htmlImagePattern = regexp.MustCompile(`(?i)(<img\b[^>]*?\ssrc\s*=\s*)("[^"]*"|'[^']*'|[^\s"'>]+)`)
+	// Fenced code block delimiters
+	fencePattern = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+)
+
+// Ref rewrites a single image reference (e.g. a feature image)
+//
+// References that are not local files are returned unchanged.
+func (r *Rewriter) Ref(ref string) (string, error) {
+	path, local, err := r.resolve(ref)
+	if err != nil || !local {
+		return ref, err
+	}
+
+	if r.urls == nil {
+		r.urls = map[string]string{}
+	}
+	if u, ok := r.urls[path]; ok {
+		return u, nil
+	}
+
+	u, err := r.Upload(path)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", ref, err)
+	}
+	r.urls[path] = u
+	return u, nil
+}
+
+// Markdown rewrites image references in Markdown, leaving fenced code blocks untouched
+//
+// Raw HTML <img> tags inside the Markdown are rewritten as well.
+func (r *Rewriter) Markdown(content string) (string, error) {
+	var out, chunk strings.Builder
+	var fence string
+	var firstErr error
+
+	// flush rewrites the pending chunk of non-code lines
+	flush := func() {
+		text := chunk.String()
+		chunk.Reset()
+		text = r.replace(markdownImagePattern, text, false, &firstErr)
+		text = r.replace(markdownRefPattern, text, true, &firstErr)
+		text = r.replace(htmlImagePattern, text, false, &firstErr)
+		out.WriteString(text)
+	}
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		marker := fencePattern.FindStringSubmatch(line)
+		switch {
+		case fence == "" && marker != nil:
+			// Opening fence
+			flush()
+			fence = marker[1]
+			out.WriteString(line)
+		case fence != "":
+			// Inside a code block until a matching closing fence
+			out.WriteString(line)
+			if marker != nil && marker[1][0] == fence[0] && len(marker[1]) >= len(fence) && strings.TrimSpace(line[len(marker[0]):]) == "" {
+				fence = ""
+			}
+		default:
+			chunk.WriteString(line)
+		}
+	}
+	flush()
+
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return out.String(), nil
+}
+
+// HTML rewrites the src attribute of <img> tags
+func (r *Rewriter) HTML(content string) (string, error) {
+	var firstErr error
+	content = r.replace(htmlImagePattern, content, false, &firstErr)
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return content, nil
+}
+
+// replace rewrites the destination (second group) of every match
+//
+// imagesOnly skips destinations without an image extension (reference
+// definitions are shared by links and images).
+func (r *Rewriter) replace(pattern *regexp.Regexp, text string, imagesOnly bool, firstErr *error) string {
+	return pattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := pattern.FindStringSubmatch(match)
+		prefix, dest := groups[1], groups[2]
+
+		// Remove <...> or quotes around the destination
+		left, ref, right := "", dest, ""
+		if len(dest) >= 2 && strings.Contains(`<"'`, dest[:1]) {
+			left, ref, right = dest[:1], dest[1:len(dest)-1], dest[len(dest)-1:]
+		}
+		isHTML := pattern == htmlImagePattern
+		if isHTML {
+			ref = html.UnescapeString(ref)
+		}
+
+		if imagesOnly && !IsImagePath(ref) {
+			return match
+		}
+
+		rewritten, err := r.Ref(ref)
+		if err != nil {
+			if *firstErr == nil {
+				*firstErr = err
+			}
+			return match
+		}
+		if rewritten == ref {
+			return match
+		}
+		if isHTML {
+			rewritten = html.EscapeString(rewritten)
+		}
+		return prefix + left + rewritten + right
+	})
+}
+
+// resolve returns the absolute file path of a local reference
+//
+// Remote URLs, data URIs, and fragments are not local. Relative paths must
+// exist; absolute paths that do not exist are assumed to be site paths
+// (e.g. /content/images/...).
+func (r *Rewriter) resolve(ref string) (path string, local bool, err error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.HasPrefix(ref, "#") || strings.HasPrefix(ref, "//") {
+		return "", false, nil
+	}
+
+	path = ref
+	if u, err := url.Parse(ref); err == nil && u.Scheme != "" {
+		switch {
+		case u.Scheme == "file":
+			path = u.Path
+		case len(u.Scheme) > 1:
+			// http:, https:, data:, ... (a single letter is a Windows drive)
+			return "", false, nil
+		}
+	}
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		path = unescaped
+	}
+
+	if filepath.IsAbs(path) {
+		if _, err := os.Stat(path); err != nil {
+			return "", false, nil
+		}
+		return path, true, nil
+	}
+
+	path, err = filepath.Abs(filepath.Join(r.BaseDir, path))
+	if err != nil {
+		return "", false, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", false, fmt.Errorf("image not found: %s", ref)
+	}
+	return path, true, nil
+}
+
+// IsImagePath reports whether a path has an image file extension
+func IsImagePath(path string) bool {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	_, ok := filetype.Image.Extensions[strings.ToLower(filepath.Ext(path))]
+	return ok
+}
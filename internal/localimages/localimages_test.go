@@ -0,0 +1,129 @@
+/**
+ * localimages_test.go
+ * Test code for local image reference rewriting and the upload cache
+ */
+
+package localimages
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestRewriter creates a rewriter over a directory with img/chart.png and cover.jpg
+func newTestRewriter(t *testing.T) (*Rewriter, *[]string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "img"), 0755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	for _, name := range []string{"img/chart.png", "cover.jpg", "my photo.png"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("data"), 0644); err != nil {
+			t.Fatalf("failed to create file: %v", err)
+		}
+	}
+
+	uploaded := []string{}
+	r := &Rewriter{BaseDir: dir, Upload: func(path string) (string, error) {
+		uploaded = append(uploaded, path)
+		return "https://example.com/content/images/" + filepath.Base(path), nil
+	}}
+	return r, &uploaded
+}
+
+// TestRewriter_Markdown verifies inline images, reference definitions, and code blocks
+func TestRewriter_Markdown(t *testing.T) {
+	r, uploaded := newTestRewriter(t)
+
+	src := "![Chart](./img/chart.png \"Sales\")\n" +
+		"![again](img/chart.png)\n" +
+		"![remote](https://cdn.example.com/a.png)\n" +
+		"![spaced](<my photo.png>)\n" +
+		"[link]: ./cover.jpg\n" +
+		"[page]: ./other.html\n" +
+		"```\n![code](./missing.png)\n```\n"
+
+	got, err := r.Markdown(src)
+	if err != nil {
+		t.Fatalf("Markdown() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"![Chart](https://example.com/content/images/chart.png \"Sales\")",
+		"![again](https://example.com/content/images/chart.png)",
+		"![remote](https://cdn.example.com/a.png)",
+		"![spaced](<https://example.com/content/images/my photo.png>)",
+		"[link]: https://example.com/content/images/cover.jpg",
+		"[page]: ./other.html",
+		"![code](./missing.png)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Markdown() output missing %q:\n%s", want, got)
+		}
+	}
+
+	// Each file is uploaded once
+	if len(*uploaded) != 3 {
+		t.Errorf("uploaded = %v; want 3 distinct files", *uploaded)
+	}
+}
+
+// TestRewriter_HTML verifies <img src> rewriting, including escaped paths
+func TestRewriter_HTML(t *testing.T) {
+	r, _ := newTestRewriter(t)
+
+	got, err := r.HTML(`<p><img alt="x" src="img/chart.png"><img src='my%20photo.png'><img src="/content/images/site.png"></p>`)
+	if err != nil {
+		t.Fatalf("HTML() error = %v", err)
+	}
+	want := `<p><img alt="x" src="https://example.com/content/images/chart.png"><img src='https://example.com/content/images/my photo.png'><img src="/content/images/site.png"></p>`
+	if got != want {
+		t.Errorf("HTML() = %s\nwant %s", got, want)
+	}
+}
+
+// TestRewriter_MissingFile verifies that a broken relative reference is reported
+func TestRewriter_MissingFile(t *testing.T) {
+	r, uploaded := newTestRewriter(t)
+
+	_, err := r.Markdown("![x](./img/nope.png)\n")
+	if err == nil || !strings.Contains(err.Error(), "nope.png") {
+		t.Errorf("Markdown() error = %v; want image not found", err)
+	}
+	if len(*uploaded) != 0 {
+		t.Errorf("uploaded = %v; want none", *uploaded)
+	}
+}
+
+// TestCache verifies lookup by content hash and persistence
+func TestCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "uploads.json")
+
+	cache, err := LoadCache(path)
+	if err != nil {
+		t.Fatalf("LoadCache() error = %v", err)
+	}
+	hash := HashData([]byte("data"))
+	cache.Store("https://a.example.com", "/img/a.png", hash, "https://a.example.com/a.png")
+	if err := cache.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := LoadCache(path)
+	if err != nil {
+		t.Fatalf("LoadCache() error = %v", err)
+	}
+	if url, ok := loaded.Lookup("https://a.example.com", "/img/a.png", hash); !ok || url != "https://a.example.com/a.png" {
+		t.Errorf("Lookup() = %q, %v", url, ok)
+	}
+
+	// Changed content or another site misses
+	if _, ok := loaded.Lookup("https://a.example.com", "/img/a.png", HashData([]byte("new"))); ok {
+		t.Error("Lookup() hit for changed content")
+	}
+	if _, ok := loaded.Lookup("https://b.example.com", "/img/a.png", hash); ok {
+		t.Error("Lookup() hit for another site")
+	}
+}
@@ -8,11 +8,9 @@ package markdown
 
 import (
 	"bytes"
-
-	"github.com/yuin/goldmark"
 )
 
-// ConvertToHTML converts a Markdown string to HTML
+// ConvertToHTML converts a Markdown string to HTML with the default options
 //
 // Parameters:
 //   - markdown: source Markdown string
@@ -29,6 +27,11 @@ import (
 //	}
 //	fmt.Println(html)
 func ConvertToHTML(markdown string) (string, error) {
+	return ConvertToHTMLWithOptions(markdown, DefaultOptions())
+}
+
+// ConvertToHTMLWithOptions converts a Markdown string to HTML with the given options
+func ConvertToHTMLWithOptions(markdown string, opts Options) (string, error) {
 	// Return as is for empty string
 	if markdown == "" {
 		return "", nil
@@ -37,8 +40,8 @@ func ConvertToHTML(markdown string) (string, error) {
 	// Prepare buffer
 	var buf bytes.Buffer
 
-	// Convert Markdown to HTML using goldmark
-	if err := goldmark.Convert([]byte(markdown), &buf); err != nil {
+	// Convert Markdown to HTML using goldmark (shortcodes become Ghost card HTML)
+	if err := opts.New().Convert([]byte(markdown), &buf); err != nil {
 		return "", err
 	}
 
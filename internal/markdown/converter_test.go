@@ -34,22 +34,22 @@ func TestConvertToHTML_Headings(t *testing.T) {
 		{
 			name:     "h1 heading",
 			markdown: "# Heading 1",
-			expected: "<h1>Heading 1</h1>\n",
+			expected: "<h1 id=\"heading-1\">Heading 1</h1>\n",
 		},
 		{
 			name:     "h2 heading",
 			markdown: "## Heading 2",
-			expected: "<h2>Heading 2</h2>\n",
+			expected: "<h2 id=\"heading-2\">Heading 2</h2>\n",
 		},
 		{
 			name:     "h3 heading",
 			markdown: "### Heading 3",
-			expected: "<h3>Heading 3</h3>\n",
+			expected: "<h3 id=\"heading-3\">Heading 3</h3>\n",
 		},
 		{
 			name:     "h6 heading",
 			markdown: "###### Heading 6",
-			expected: "<h6>Heading 6</h6>\n",
+			expected: "<h6 id=\"heading-6\">Heading 6</h6>\n",
 		},
 	}
 
@@ -192,7 +192,7 @@ It also includes a [link](https://example.com).`
 
 	// Verify each element is included
 	expectedElements := []string{
-		"<h1>Heading</h1>",
+		"<h1 id=\"heading\">Heading</h1>",
 		"<strong>bold</strong>",
 		"<em>italic</em>",
 		"<ul>",
@@ -206,3 +206,63 @@ It also includes a [link](https://example.com).`
 		}
 	}
 }
+
+// TestConvertToHTMLWithOptions_GFM tests the GitHub-flavored Markdown extensions
+func TestConvertToHTMLWithOptions_GFM(t *testing.T) {
+	markdown := "| A | B |\n|---|---|\n| 1 | 2 |\n\n- [x] done\n\n~~old~~ https://example.com and a note[^1]\n\n[^1]: The note\n"
+
+	html, err := ConvertToHTMLWithOptions(markdown, DefaultOptions())
+	if err != nil {
+		t.Fatalf("Conversion error occurred: %v", err)
+	}
+	for _, elem := range []string{
+		"<table>", "<td>1</td>",
+		`<input checked="" disabled="" type="checkbox"`,
+		"<del>old</del>",
+		`<a href="https://example.com">https://example.com</a>`,
+		`class="footnotes"`,
+	} {
+		if !strings.Contains(html, elem) {
+			t.Errorf("Expected element not included: %q\nHTML: %q", elem, html)
+		}
+	}
+
+	// Disabled extensions leave the syntax as text
+	opts, err := DefaultOptions().Apply(map[string]bool{"tables": false, "strikethrough": false})
+	if err != nil {
+		t.Fatalf("Apply() error: %v", err)
+	}
+	html, err = ConvertToHTMLWithOptions("| A | B |\n|---|---|\n\n~~old~~", opts)
+	if err != nil {
+		t.Fatalf("Conversion error occurred: %v", err)
+	}
+	if strings.Contains(html, "<table>") || strings.Contains(html, "<del>") {
+		t.Errorf("Disabled extensions were applied: %q", html)
+	}
+}
+
+// TestConvertToHTMLWithOptions_Renderer tests typographer, hard wraps, and unsafe HTML
+func TestConvertToHTMLWithOptions_Renderer(t *testing.T) {
+	opts, err := DefaultOptions().Apply(map[string]bool{"typographer": true, "hard_wraps": true, "unsafe": true})
+	if err != nil {
+		t.Fatalf("Apply() error: %v", err)
+	}
+	html, err := ConvertToHTMLWithOptions("\"Quoted\" -- text\nnext <span>raw</span>", opts)
+	if err != nil {
+		t.Fatalf("Conversion error occurred: %v", err)
+	}
+	expected := "<p>&ldquo;Quoted&rdquo; &ndash; text<br>\nnext <span>raw</span></p>\n"
+	if html != expected {
+		t.Errorf("Does not match expected value.\nExpected: %q\nActual: %q", expected, html)
+	}
+}
+
+// TestOptions_UnknownName tests that unknown option names are rejected
+func TestOptions_UnknownName(t *testing.T) {
+	if _, err := DefaultOptions().Apply(map[string]bool{"emoji": true}); err == nil {
+		t.Error("Expected an error for an unknown option")
+	}
+	if _, err := DefaultOptions().Get("tables"); err != nil {
+		t.Errorf("Get() error: %v", err)
+	}
+}
@@ -0,0 +1,422 @@
+/**
+ * HTML to Markdown rendering
+ *
+ * Converts HTML (e.g. Ghost's rendered post HTML, used when a post has no
+ * Lexical) to Markdown. Ghost cards are mapped like FromLexical maps them;
+ * elements without a Markdown equivalent are kept as raw HTML blocks.
+ */
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// htmlNode is an element or text node of a parsed HTML fragment
+type htmlNode struct {
+	Tag      string // Lowercase tag name ("" for text)
+	Attrs    map[string]string
+	Text     string // Decoded text of text nodes
+	Raw      string // Original markup of the element including its end tag
+	Children []*htmlNode
+}
+
+// voidTags are elements without end tags
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// blockTags are elements rendered as Markdown blocks
+var blockTags = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "pre": true, "blockquote": true, "hr": true, "figure": true,
+	"div": true, "table": true, "section": true, "article": true, "header": true, "footer": true,
+	"aside": true, "iframe": true, "script": true, "style": true, "video": true, "audio": true,
+	"form": true, "details": true, "dl": true, "hgroup": true, "nav": true,
+}
+
+var (
+	// Start tag name and the rest of the tag
+	startTagPattern = regexp.MustCompile(`^<([a-zA-Z][a-zA-Z0-9-]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	// End tag name
+	endTagPattern = regexp.MustCompile(`^</([a-zA-Z][a-zA-Z0-9-]*)\s*>`)
+	// Attribute name and optional value
+	attrPattern = regexp.MustCompile(`([^\s=/>"']+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+)))?`)
+	// Runs of whitespace
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// parseHTML parses an HTML fragment into a tree (tolerating unclosed tags)
+func parseHTML(s string) *htmlNode {
+	root := &htmlNode{Tag: "#root"}
+	stack := []*htmlNode{root}
+	starts := []int{0}
+
+	appendChild := func(n *htmlNode) {
+		parent := stack[len(stack)-1]
+		parent.Children = append(parent.Children, n)
+	}
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			// Comments (e.g. Ghost's <!--kg-card-begin: html-->) are dropped
+			end := strings.Index(rest, "-->")
+			if end < 0 {
+				i = len(s)
+			} else {
+				i += end + 3
+			}
+		case endTagPattern.MatchString(rest):
+			m := endTagPattern.FindStringSubmatch(rest)
+			name := strings.ToLower(m[1])
+			i += len(m[0])
+			// Close up to the matching element; ignore stray end tags
+			for depth := len(stack) - 1; depth > 0; depth-- {
+				if stack[depth].Tag == name {
+					for j := len(stack) - 1; j >= depth; j-- {
+						stack[j].Raw = s[starts[j]:i]
+					}
+					stack, starts = stack[:depth], starts[:depth]
+					break
+				}
+			}
+		case startTagPattern.MatchString(rest):
+			m := startTagPattern.FindStringSubmatch(rest)
+			n := &htmlNode{Tag: strings.ToLower(m[1]), Attrs: parseAttrs(m[2])}
+			appendChild(n)
+			start := i
+			i += len(m[0])
+			if voidTags[n.Tag] || strings.HasSuffix(m[2], "/") {
+				n.Raw = s[start:i]
+				continue
+			}
+			if n.Tag == "script" || n.Tag == "style" || n.Tag == "pre" {
+				// Raw text elements end at their own end tag (code may contain "<")
+				if end := strings.Index(strings.ToLower(s[i:]), "</"+n.Tag); end >= 0 {
+					inner := s[i : i+end]
+					if n.Tag == "pre" {
+						n.Children = parseHTML(inner).Children
+					} else {
+						n.Children = []*htmlNode{{Text: inner}}
+					}
+					i += end + strings.Index(s[i+end:], ">") + 1
+					n.Raw = s[start:i]
+					continue
+				}
+			}
+			stack, starts = append(stack, n), append(starts, start)
+		default:
+			// Text up to the next tag
+			end := strings.Index(rest[1:], "<")
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end++
+			}
+			appendChild(&htmlNode{Text: html.UnescapeString(rest[:end])})
+			i += end
+		}
+	}
+
+	// Close anything left open
+	for j := len(stack) - 1; j > 0; j-- {
+		stack[j].Raw = s[starts[j]:]
+	}
+	return root
+}
+
+// parseAttrs parses the attributes of a start tag
+func parseAttrs(s string) map[string]string {
+	attrs := map[string]string{}
+	for _, m := range attrPattern.FindAllStringSubmatch(s, -1) {
+		attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2] + m[3] + m[4])
+	}
+	return attrs
+}
+
+// hasClass reports whether the node has the given class
+func (n *htmlNode) hasClass(class string) bool {
+	for _, c := range strings.Fields(n.Attrs["class"]) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the first descendant matching the predicate
+func (n *htmlNode) find(match func(*htmlNode) bool) *htmlNode {
+	for _, child := range n.Children {
+		if child.Tag != "" && match(child) {
+			return child
+		}
+		if found := child.find(match); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// innerText returns the text content of a node
+func innerText(n *htmlNode) string {
+	if n.Tag == "" {
+		return n.Text
+	}
+	var b strings.Builder
+	for _, child := range n.Children {
+		if child.Tag == "br" {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString(innerText(child))
+	}
+	return b.String()
+}
+
+// FromHTML converts HTML to Markdown
+func FromHTML(s string) string {
+	return joinBlocks(htmlBlocks(parseHTML(s).Children))
+}
+
+// HTMLText returns the plain text of an HTML fragment with whitespace collapsed
+func HTMLText(s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return strings.TrimSpace(s)
+	}
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(innerText(parseHTML(s)), " "))
+}
+
+// htmlBlocks renders a sequence of nodes as Markdown blocks
+//
+// Consecutive inline nodes are grouped into paragraphs.
+func htmlBlocks(nodes []*htmlNode) []string {
+	var blocks []string
+	var inline []*htmlNode
+
+	flush := func() {
+		if text := strings.TrimSpace(htmlInline(inline)); text != "" {
+			blocks = append(blocks, escapeLineStart(text))
+		}
+		inline = nil
+	}
+
+	for _, n := range nodes {
+		if n.Tag == "" || !blockTags[n.Tag] {
+			inline = append(inline, n)
+			continue
+		}
+		flush()
+		if block := htmlBlock(n); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	flush()
+	return blocks
+}
+
+// htmlBlock renders a block-level element
+func htmlBlock(n *htmlNode) string {
+	switch n.Tag {
+	case "p":
+		return escapeLineStart(strings.TrimSpace(htmlInline(n.Children)))
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return strings.Repeat("#", int(n.Tag[1]-'0')) + " " + strings.TrimSpace(htmlInline(n.Children))
+	case "blockquote":
+		return quoteLines(strings.TrimSpace(joinBlocks(htmlBlocks(n.Children))))
+	case "ul", "ol":
+		return htmlList(n, "")
+	case "pre":
+		language := ""
+		if code := n.find(func(c *htmlNode) bool { return c.Tag == "code" }); code != nil {
+			for _, class := range strings.Fields(code.Attrs["class"]) {
+				if strings.HasPrefix(class, "language-") {
+					language = strings.TrimPrefix(class, "language-")
+				}
+			}
+		}
+		return fencedCode(innerText(n), language)
+	case "hr":
+		return "---"
+	case "figure":
+		if n.hasClass("kg-bookmark-card") {
+			if a := n.find(func(c *htmlNode) bool { return c.Tag == "a" }); a != nil && a.Attrs["href"] != "" {
+				return "<" + a.Attrs["href"] + ">"
+			}
+		}
+		img := n.find(func(c *htmlNode) bool { return c.Tag == "img" })
+		isImageFigure := img != nil && !n.hasClass("kg-gallery-card") && n.find(func(c *htmlNode) bool {
+			return c.Tag == "video" || c.Tag == "iframe"
+		}) == nil
+		if isImageFigure {
+			caption := ""
+			if figcaption := n.find(func(c *htmlNode) bool { return c.Tag == "figcaption" }); figcaption != nil {
+				caption = HTMLText(innerText(figcaption))
+			}
+			image := imageMarkdown(img.Attrs["alt"], img.Attrs["src"], caption)
+			if a := n.find(func(c *htmlNode) bool { return c.Tag == "a" }); a != nil && a.Attrs["href"] != "" {
+				image = "[" + image + "](" + linkDestination(a.Attrs["href"]) + ")"
+			}
+			return image
+		}
+		return strings.TrimSpace(n.Raw)
+	case "div":
+		if card := ghostCard(n); card != nil {
+			// Cards with a Markdown form are rendered like their Lexical counterparts
+			if markdown := cardMarkdown(card); markdown != "" {
+				return markdown
+			}
+		}
+		if strings.Contains(n.Attrs["class"], "kg-") {
+			// Other Ghost cards are kept as HTML
+			return strings.TrimSpace(n.Raw)
+		}
+		return strings.TrimSpace(joinBlocks(htmlBlocks(n.Children)))
+	default:
+		return strings.TrimSpace(n.Raw)
+	}
+}
+
+// ghostCard converts Ghost callout, toggle, and button card markup to Lexical cards
+//
+// Returns nil for other elements.
+func ghostCard(n *htmlNode) lexical.Node {
+	part := func(class string) *htmlNode {
+		return n.find(func(c *htmlNode) bool { return c.hasClass(class) })
+	}
+	switch {
+	case n.hasClass("kg-callout-card"):
+		fields := map[string]interface{}{"calloutEmoji": "", "calloutText": "", "backgroundColor": classSuffix(n, "kg-callout-card-")}
+		if emoji := part("kg-callout-emoji"); emoji != nil {
+			fields["calloutEmoji"] = strings.TrimSpace(innerText(emoji))
+		}
+		if text := part("kg-callout-text"); text != nil {
+			fields["calloutText"] = innerHTML(text)
+		}
+		return lexical.Card("callout", fields)
+	case n.hasClass("kg-toggle-card"):
+		fields := map[string]interface{}{"heading": "", "content": ""}
+		if heading := part("kg-toggle-heading-text"); heading != nil {
+			fields["heading"] = innerHTML(heading)
+		}
+		if content := part("kg-toggle-content"); content != nil {
+			fields["content"] = innerHTML(content)
+		}
+		return lexical.Card("toggle", fields)
+	case n.hasClass("kg-button-card"):
+		fields := map[string]interface{}{"buttonText": "", "buttonUrl": "", "alignment": classSuffix(n, "kg-align-")}
+		if a := n.find(func(c *htmlNode) bool { return c.Tag == "a" }); a != nil {
+			fields["buttonText"] = strings.Join(strings.Fields(innerText(a)), " ")
+			fields["buttonUrl"] = a.Attrs["href"]
+		}
+		return lexical.Card("button", fields)
+	}
+	return nil
+}
+
+// classSuffix returns the rest of the first class of n starting with prefix
+func classSuffix(n *htmlNode, prefix string) string {
+	for _, class := range strings.Fields(n.Attrs["class"]) {
+		if suffix := strings.TrimPrefix(class, prefix); suffix != class {
+			return suffix
+		}
+	}
+	return ""
+}
+
+// innerHTML returns the markup between the start and end tags of n
+func innerHTML(n *htmlNode) string {
+	raw := n.Raw
+	if i := strings.Index(raw, ">"); i >= 0 {
+		raw = raw[i+1:]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(raw, "</"+n.Tag+">"))
+}
+
+// htmlList renders a list element with the given indentation
+func htmlList(n *htmlNode, indent string) string {
+	number := 1
+	if start, err := strconv.Atoi(n.Attrs["start"]); err == nil && start > 0 {
+		number = start
+	}
+
+	var lines []string
+	for _, item := range n.Children {
+		if item.Tag != "li" {
+			continue
+		}
+		marker := "- "
+		if n.Tag == "ol" {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		pad := indent + strings.Repeat(" ", len(marker))
+
+		var inline []*htmlNode
+		var nested []string
+		for _, child := range item.Children {
+			switch {
+			case child.Tag == "ul" || child.Tag == "ol":
+				nested = append(nested, htmlList(child, pad))
+			case child.Tag == "p":
+				inline = append(inline, child.Children...)
+			default:
+				inline = append(inline, child)
+			}
+		}
+		text := strings.TrimSpace(htmlInline(inline))
+		lines = append(lines, indent+marker+strings.ReplaceAll(text, "\n", "\n"+pad))
+		lines = append(lines, nested...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// htmlInline renders inline nodes
+func htmlInline(nodes []*htmlNode) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Tag {
+		case "":
+			b.WriteString(escapeText(whitespacePattern.ReplaceAllString(n.Text, " ")))
+		case "strong", "b":
+			b.WriteString(wrapInline(htmlInline(n.Children), "**", "**"))
+		case "em", "i":
+			b.WriteString(wrapInline(htmlInline(n.Children), "*", "*"))
+		case "s", "del", "strike":
+			b.WriteString(wrapInline(htmlInline(n.Children), "~~", "~~"))
+		case "code":
+			b.WriteString(codeSpan(innerText(n)))
+		case "a":
+			fmt.Fprintf(&b, "[%s](%s)", strings.TrimSpace(htmlInline(n.Children)), linkDestination(n.Attrs["href"]))
+		case "img":
+			b.WriteString(imageMarkdown(n.Attrs["alt"], n.Attrs["src"], n.Attrs["title"]))
+		case "br":
+			b.WriteString("\\\n")
+		case "span", "font", "small", "label", "abbr", "cite", "time":
+			b.WriteString(htmlInline(n.Children))
+		case "u", "mark", "sub", "sup":
+			b.WriteString(wrapInline(htmlInline(n.Children), "<"+n.Tag+">", "</"+n.Tag+">"))
+		default:
+			b.WriteString(strings.TrimSpace(n.Raw))
+		}
+	}
+	return b.String()
+}
+
+// wrapInline wraps rendered text in markers, keeping surrounding spaces outside
+func wrapInline(text, before, after string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	lead := text[:strings.Index(text, trimmed)]
+	trail := text[len(lead)+len(trimmed):]
+	return lead + before + trimmed + after + trail
+}
@@ -0,0 +1,555 @@
+/**
+ * Markdown to Lexical conversion functionality
+ *
+ * Converts Markdown directly to Ghost's Lexical JSON, so that code block
+ * languages, nested lists, and image captions survive (the HTML route goes
+ * through Ghost's lossy source=html conversion). Besides standard Markdown:
+ *   - An image alone in a paragraph becomes an image card; its title is the caption
+ *   - GitHub-style alerts (> [!NOTE], > [!TIP], ...) become callout cards
+ *   - An autolink alone in a paragraph (<https://example.com>) becomes a bookmark card
+ *   - Shortcodes (:::callout, :::toggle, ...) become the matching cards
+ *
+ * Inline formatting tags (<u>, <mark>, <sup>, <sub>, ...) become text formats.
+ * Markdown that has no Lexical equivalent (other raw HTML, inline images
+ * inside text) is kept as HTML cards. Raw HTML is always kept on this path;
+ * the unsafe option only applies to HTML output.
+ */
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// calloutStyles maps GitHub alert types to callout emoji and background colors
+var calloutStyles = map[string][2]string{
+	"NOTE":      {"ℹ️", "blue"},
+	"TIP":       {"💡", "green"},
+	"IMPORTANT": {"❗", "purple"},
+	"WARNING":   {"⚠️", "yellow"},
+	"CAUTION":   {"🚨", "red"},
+}
+
+var (
+	// First line of a GitHub alert blockquote
+	alertPattern = regexp.MustCompile(`^\[!([A-Za-z]+)\]\s*$`)
+	// Alert marker at the start of the rendered callout HTML
+	alertHTMLPattern = regexp.MustCompile(`^<p>\[![A-Za-z]+\]\s*`)
+)
+
+// ConvertToLexical converts a Markdown string to a Lexical JSON string with the default options
+//
+// Example usage:
+//
+//	lexicalJSON, err := ConvertToLexical("# Heading\n\n```go\nfmt.Println()\n```")
+func ConvertToLexical(markdown string) (string, error) {
+	return ConvertToLexicalWithOptions(markdown, DefaultOptions())
+}
+
+// ConvertToLexicalWithOptions converts a Markdown string to a Lexical JSON string
+func ConvertToLexicalWithOptions(markdown string, opts Options) (string, error) {
+	// HTML cards keep raw HTML, as HTML blocks do
+	opts.Unsafe = true
+	doc := convertLexical(opts.New(), markdown, opts.HardWraps)
+	return doc.String()
+}
+
+// ConvertToLexicalDocument converts Markdown to a Lexical document using the given goldmark instance
+//
+// Inline raw HTML in HTML cards is kept only if the instance renders raw HTML (html.WithUnsafe).
+func ConvertToLexicalDocument(md goldmark.Markdown, markdown string) *lexical.Document {
+	return convertLexical(md, markdown, false)
+}
+
+// convertLexical parses Markdown and converts it to a Lexical document
+func convertLexical(md goldmark.Markdown, markdown string, hardWraps bool) *lexical.Document {
+	source := []byte(markdown)
+	c := &lexicalConverter{md: md, source: source, hardWraps: hardWraps}
+	root := md.Parser().Parse(text.NewReader(source))
+	return lexical.NewDocument(c.blocks(root)...)
+}
+
+// lexicalConverter converts a parsed goldmark AST to Lexical nodes
+type lexicalConverter struct {
+	md        goldmark.Markdown
+	source    []byte
+	hardWraps bool // Soft line breaks become line breaks
+}
+
+// blocks converts the block children of n
+func (c *lexicalConverter) blocks(n ast.Node) []lexical.Node {
+	var nodes []lexical.Node
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		nodes = append(nodes, c.block(child)...)
+	}
+	return nodes
+}
+
+// block converts one block node (some Markdown blocks become several cards)
+func (c *lexicalConverter) block(n ast.Node) []lexical.Node {
+	switch n := n.(type) {
+	case *ast.Heading:
+		if inline, ok := c.inlines(n, 0); ok {
+			heading := lexical.Element("heading", inline...)
+			heading["tag"] = fmt.Sprintf("h%d", n.Level)
+			return []lexical.Node{heading}
+		}
+	case *ast.Paragraph, *ast.TextBlock:
+		if cards := c.paragraphCards(n); cards != nil {
+			return cards
+		}
+		if inline, ok := c.inlines(n, 0); ok {
+			return []lexical.Node{lexical.Element("paragraph", inline...)}
+		}
+	case *ast.Blockquote:
+		if callout := c.callout(n); callout != nil {
+			return []lexical.Node{callout}
+		}
+		if inline, ok := c.quoteInlines(n); ok {
+			return []lexical.Node{lexical.Element("quote", inline...)}
+		}
+	case *ast.List:
+		return []lexical.Node{c.list(n)}
+	case *ast.FencedCodeBlock:
+		return []lexical.Node{lexical.Card("codeblock", map[string]interface{}{
+			"code":     c.lines(n),
+			"language": string(n.Language(c.source)),
+			"caption":  "",
+		})}
+	case *ast.CodeBlock:
+		return []lexical.Node{lexical.Card("codeblock", map[string]interface{}{
+			"code":     c.lines(n),
+			"language": "",
+			"caption":  "",
+		})}
+	case *Shortcode:
+		return []lexical.Node{shortcodeCard(n, c.source, c.renderHTML)}
+	case *ast.ThematicBreak:
+		return []lexical.Node{lexical.Card("horizontalrule", nil)}
+	case *ast.HTMLBlock:
+		code := c.lines(n)
+		if n.HasClosure() {
+			code += string(n.ClosureLine.Value(c.source))
+		}
+		return []lexical.Node{htmlCard(code)}
+	}
+
+	// Anything else (or content Lexical cannot express) is kept as HTML
+	return []lexical.Node{htmlCard(c.renderHTML(n))}
+}
+
+// paragraphCards turns paragraphs holding only images or a single autolink into cards
+//
+// Returns nil for ordinary paragraphs.
+func (c *lexicalConverter) paragraphCards(n ast.Node) []lexical.Node {
+	// A lone <url> autolink is a bookmark (bare URLs found by linkify stay links)
+	if link, ok := n.FirstChild().(*ast.AutoLink); ok && link.NextSibling() == nil && link.AutoLinkType == ast.AutoLinkURL && c.bracketed(n) {
+		return []lexical.Node{bookmarkCard(string(link.URL(c.source)), "", "", "", "")}
+	}
+
+	// Images (optionally linked) separated only by whitespace become image cards
+	var cards []lexical.Node
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		switch child := child.(type) {
+		case *ast.Image:
+			cards = append(cards, c.imageCard(child, ""))
+		case *ast.Link:
+			image, ok := child.FirstChild().(*ast.Image)
+			if !ok || image.NextSibling() != nil {
+				return nil
+			}
+			cards = append(cards, c.imageCard(image, string(child.Destination)))
+		case *ast.Text:
+			if strings.TrimSpace(string(child.Segment.Value(c.source))) != "" {
+				return nil
+			}
+		default:
+			return nil
+		}
+	}
+	return cards
+}
+
+// bracketed reports whether a block's source starts with "<"
+func (c *lexicalConverter) bracketed(n ast.Node) bool {
+	lines := n.Lines()
+	if lines.Len() == 0 {
+		return false
+	}
+	first := lines.At(0)
+	return strings.HasPrefix(strings.TrimSpace(string(first.Value(c.source))), "<")
+}
+
+// imageCard creates an image card (the image title becomes the caption)
+func (c *lexicalConverter) imageCard(image *ast.Image, href string) lexical.Node {
+	return lexical.Card("image", map[string]interface{}{
+		"src":       unescapeText(image.Destination),
+		"alt":       c.plainText(image),
+		"title":     "",
+		"caption":   unescapeText(image.Title),
+		"href":      href,
+		"cardWidth": "regular",
+		"width":     nil,
+		"height":    nil,
+	})
+}
+
+// callout converts a GitHub-style alert blockquote to a callout card
+//
+// Returns nil for ordinary blockquotes.
+func (c *lexicalConverter) callout(n *ast.Blockquote) lexical.Node {
+	first, ok := n.FirstChild().(*ast.Paragraph)
+	if !ok || first.Lines().Len() == 0 {
+		return nil
+	}
+	line := first.Lines().At(0)
+	match := alertPattern.FindStringSubmatch(strings.TrimSpace(string(line.Value(c.source))))
+	if match == nil {
+		return nil
+	}
+	style, ok := calloutStyles[strings.ToUpper(match[1])]
+	if !ok {
+		return nil
+	}
+
+	// Render the content without the [!TYPE] marker
+	var body strings.Builder
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		body.WriteString(c.renderHTML(child))
+	}
+	calloutText := alertHTMLPattern.ReplaceAllString(body.String(), "<p>")
+	calloutText = strings.TrimSpace(strings.TrimPrefix(calloutText, "<p></p>"))
+
+	return lexical.Card("callout", map[string]interface{}{
+		"calloutText":     calloutText,
+		"calloutEmoji":    style[0],
+		"backgroundColor": style[1],
+	})
+}
+
+// quoteInlines flattens blockquote paragraphs into inline nodes separated by line breaks
+func (c *lexicalConverter) quoteInlines(n *ast.Blockquote) ([]lexical.Node, bool) {
+	var nodes []lexical.Node
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		if _, ok := child.(*ast.Paragraph); !ok {
+			return nil, false
+		}
+		inline, ok := c.inlines(child, 0)
+		if !ok {
+			return nil, false
+		}
+		if len(nodes) > 0 {
+			nodes = append(nodes, lineBreak(), lineBreak())
+		}
+		nodes = append(nodes, inline...)
+	}
+	return nodes, true
+}
+
+// list converts a list; nested lists go in their own list item, as the Ghost editor does
+func (c *lexicalConverter) list(n *ast.List) lexical.Node {
+	list := lexical.Element("list")
+	if n.IsOrdered() {
+		list["listType"], list["tag"] = "number", "ol"
+	} else {
+		list["listType"], list["tag"] = "bullet", "ul"
+	}
+	start := 1
+	if n.IsOrdered() && n.Start > 0 {
+		start = n.Start
+	}
+	list["start"] = start
+
+	var items []lexical.Node
+	value := start
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		var inline []lexical.Node
+		var nested []lexical.Node
+		for child := item.FirstChild(); child != nil; child = child.NextSibling() {
+			var converted []lexical.Node
+			switch child := child.(type) {
+			case *ast.List:
+				nested = append(nested, c.list(child))
+				continue
+			case *ast.Paragraph, *ast.TextBlock:
+				var ok bool
+				if converted, ok = c.inlines(child, 0); !ok {
+					converted = []lexical.Node{lexical.Text(c.plainText(child), 0)}
+				}
+			case *ast.FencedCodeBlock, *ast.CodeBlock:
+				// List items hold inline content only; keep code as inline code
+				converted = []lexical.Node{lexical.Text(strings.TrimRight(c.lines(child), "\n"), lexical.FormatCode)}
+			default:
+				converted = []lexical.Node{lexical.Text(c.plainText(child), 0)}
+			}
+			if len(inline) > 0 {
+				inline = append(inline, lineBreak())
+			}
+			inline = append(inline, converted...)
+		}
+
+		if len(inline) > 0 || len(nested) == 0 {
+			listItem := lexical.Element("listitem", inline...)
+			listItem["value"] = value
+			items = append(items, listItem)
+			value++
+		}
+		for _, sub := range nested {
+			listItem := lexical.Element("listitem", sub)
+			listItem["value"] = value
+			items = append(items, listItem)
+		}
+	}
+	list["children"] = items
+	return list
+}
+
+// inlines converts the inline children of n with the given text format
+//
+// ok is false if the content cannot be expressed as Lexical inline nodes.
+func (c *lexicalConverter) inlines(n ast.Node, format int) (nodes []lexical.Node, ok bool) {
+	tags := 0 // Formats opened by formatting tags
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		format := format | tags
+		switch child := child.(type) {
+		case *ast.RawHTML:
+			flag, closing, known := formatTag(c.rawHTML(child))
+			if !known {
+				return nil, false
+			}
+			if closing {
+				tags &^= flag
+			} else {
+				tags |= flag
+			}
+		case *ast.Text:
+			value := c.text(child)
+			softBreak := child.SoftLineBreak() && !c.hardWraps
+			if softBreak {
+				value += " "
+			}
+			if value != "" {
+				nodes = append(nodes, lexical.Text(value, format))
+			}
+			if child.HardLineBreak() || (child.SoftLineBreak() && c.hardWraps) {
+				nodes = append(nodes, lineBreak())
+			}
+		case *ast.String:
+			nodes = append(nodes, lexical.Text(string(child.Value), format))
+		case *ast.CodeSpan:
+			nodes = append(nodes, lexical.Text(c.plainText(child), format|lexical.FormatCode))
+		case *ast.Emphasis:
+			flag := lexical.FormatItalic
+			if child.Level >= 2 {
+				flag = lexical.FormatBold
+			}
+			inner, ok := c.inlines(child, format|flag)
+			if !ok {
+				return nil, false
+			}
+			nodes = append(nodes, inner...)
+		case *ast.Link:
+			inner, ok := c.inlines(child, format)
+			if !ok {
+				return nil, false
+			}
+			nodes = append(nodes, linkNode(unescapeText(child.Destination), unescapeText(child.Title), inner))
+		case *ast.AutoLink:
+			url := string(child.URL(c.source))
+			label := string(child.Label(c.source))
+			nodes = append(nodes, linkNode(url, "", []lexical.Node{lexical.Text(label, format)}))
+		case *extast.TaskCheckBox:
+			// Lexical has no check lists; keep the state as a ballot box
+			box := "☐ "
+			if child.IsChecked {
+				box = "☑ "
+			}
+			nodes = append(nodes, lexical.Text(box, format))
+		default:
+			// Extension inlines (strikethrough, ...) map to formats; others are not expressible
+			flag, known := inlineFormats[child.Kind().String()]
+			if !known {
+				return nil, false
+			}
+			inner, ok := c.inlines(child, format|flag)
+			if !ok {
+				return nil, false
+			}
+			nodes = append(nodes, inner...)
+		}
+	}
+	return mergeTexts(nodes), true
+}
+
+// mergeTexts joins adjacent text nodes with the same format (goldmark splits
+// text at delimiter characters such as "_" and "[")
+func mergeTexts(nodes []lexical.Node) []lexical.Node {
+	var merged []lexical.Node
+	for _, node := range nodes {
+		if n := len(merged); n > 0 && node.Type() == "extended-text" && merged[n-1].Type() == "extended-text" && node["format"] == merged[n-1]["format"] {
+			merged[n-1]["text"] = merged[n-1].String("text") + node.String("text")
+			continue
+		}
+		merged = append(merged, node)
+	}
+	return merged
+}
+
+// formatTagPattern matches an opening or closing HTML tag without attributes
+var formatTagPattern = regexp.MustCompile(`^<(/?)([A-Za-z]+)\s*>$`)
+
+// formatTags maps inline HTML tags to Lexical text formats
+var formatTags = map[string]int{
+	"u":      lexical.FormatUnderline,
+	"ins":    lexical.FormatUnderline,
+	"mark":   lexical.FormatHighlight,
+	"sup":    lexical.FormatSuperscript,
+	"sub":    lexical.FormatSubscript,
+	"s":      lexical.FormatStrikethrough,
+	"del":    lexical.FormatStrikethrough,
+	"strike": lexical.FormatStrikethrough,
+	"em":     lexical.FormatItalic,
+	"i":      lexical.FormatItalic,
+	"strong": lexical.FormatBold,
+	"b":      lexical.FormatBold,
+}
+
+// formatTag returns the text format of a formatting tag such as <u> or </mark>
+func formatTag(tag string) (flag int, closing, ok bool) {
+	match := formatTagPattern.FindStringSubmatch(tag)
+	if match == nil {
+		return 0, false, false
+	}
+	flag, ok = formatTags[strings.ToLower(match[2])]
+	return flag, match[1] == "/", ok
+}
+
+// rawHTML returns the source of an inline raw HTML node
+func (c *lexicalConverter) rawHTML(n *ast.RawHTML) string {
+	var b strings.Builder
+	for i := 0; i < n.Segments.Len(); i++ {
+		segment := n.Segments.At(i)
+		b.Write(segment.Value(c.source))
+	}
+	return b.String()
+}
+
+// inlineFormats maps extension inline node kinds to Lexical text formats
+var inlineFormats = map[string]int{
+	"Strikethrough": lexical.FormatStrikethrough,
+}
+
+// linkNode creates a Lexical link node
+func linkNode(url, title string, children []lexical.Node) lexical.Node {
+	link := lexical.Element("link", children...)
+	link["url"] = url
+	link["rel"] = nil
+	link["target"] = nil
+	link["title"] = nil
+	if title != "" {
+		link["title"] = title
+	}
+	return link
+}
+
+// lineBreak creates a Lexical line break node
+func lineBreak() lexical.Node {
+	return lexical.Node{"type": "linebreak", "version": 1}
+}
+
+// htmlCard creates an HTML card
+func htmlCard(html string) lexical.Node {
+	return lexical.Card("html", map[string]interface{}{"html": strings.TrimSpace(html)})
+}
+
+// lines returns the raw source lines of a block
+func (c *lexicalConverter) lines(n ast.Node) string {
+	var b strings.Builder
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		b.Write(segment.Value(c.source))
+	}
+	return b.String()
+}
+
+// plainText returns the text content of n and its descendants
+func (c *lexicalConverter) plainText(n ast.Node) string {
+	var b strings.Builder
+	_ = ast.Walk(n, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := node.(type) {
+		case *ast.CodeSpan:
+			// Code span content is literal (no escapes or references)
+			for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+				if t, ok := child.(*ast.Text); ok {
+					b.Write(t.Segment.Value(c.source))
+				}
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			b.WriteString(c.text(node))
+			if node.SoftLineBreak() {
+				b.WriteString(" ")
+			}
+		case *ast.String:
+			b.Write(node.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return b.String()
+}
+
+// text returns the text of a Text node as goldmark's HTML renderer reads it:
+// backslash escapes and character references are resolved (raw text is kept)
+func (c *lexicalConverter) text(n *ast.Text) string {
+	value := n.Segment.Value(c.source)
+	if n.IsRaw() {
+		return string(value)
+	}
+	return unescapeText(value)
+}
+
+// unescapeText resolves backslash escapes and entity/numeric references
+//
+// Escaped characters are taken literally, so "\&amp;" stays "&amp;".
+func unescapeText(source []byte) string {
+	var b bytes.Buffer
+	start := 0
+	for i := 0; i < len(source)-1; i++ {
+		if source[i] == '\\' && util.IsPunct(source[i+1]) {
+			b.Write(resolveReferences(source[start:i]))
+			b.WriteByte(source[i+1])
+			i++
+			start = i + 1
+		}
+	}
+	b.Write(resolveReferences(source[start:]))
+	return b.String()
+}
+
+// resolveReferences resolves numeric and named character references
+func resolveReferences(source []byte) []byte {
+	return util.ResolveEntityNames(util.ResolveNumericReferences(source))
+}
+
+// renderHTML renders a single node with goldmark
+func (c *lexicalConverter) renderHTML(n ast.Node) string {
+	var buf bytes.Buffer
+	if err := c.md.Renderer().Render(&buf, c.source, n); err != nil {
+		return ""
+	}
+	return buf.String()
+}
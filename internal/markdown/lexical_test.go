@@ -0,0 +1,231 @@
+/**
+ * Test code for Markdown→Lexical conversion functionality
+ */
+package markdown
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yuin/goldmark"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// convertForTest converts Markdown and returns the top-level nodes
+func convertForTest(t *testing.T, markdown string) []lexical.Node {
+	t.Helper()
+	lexicalJSON, err := ConvertToLexical(markdown)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	doc, err := lexical.Parse(lexicalJSON)
+	if err != nil {
+		t.Fatalf("converted JSON does not parse: %v", err)
+	}
+	return doc.Root.Children()
+}
+
+// TestConvertToLexical_TextFormats tests headings, paragraphs, emphasis, code spans, and links
+func TestConvertToLexical_TextFormats(t *testing.T) {
+	nodes := convertForTest(t, "## Title\n\nHello **bold** *it* `code` [link](https://example.com \"Tip\")\n")
+	if len(nodes) != 2 {
+		t.Fatalf("got %d nodes; want 2", len(nodes))
+	}
+	if nodes[0].Type() != "heading" || nodes[0].String("tag") != "h2" {
+		t.Errorf("heading = %v", nodes[0])
+	}
+
+	inline := nodes[1].Children()
+	formats := map[string]int{}
+	for _, n := range inline {
+		formats[n.String("text")] = n.Int("format")
+	}
+	if formats["bold"] != lexical.FormatBold || formats["it"] != lexical.FormatItalic || formats["code"] != lexical.FormatCode {
+		t.Errorf("formats = %v", formats)
+	}
+	link := inline[len(inline)-1]
+	if link.Type() != "link" || link.String("url") != "https://example.com" || link.String("title") != "Tip" {
+		t.Errorf("link = %v", link)
+	}
+}
+
+// TestConvertToLexical_EscapesAndReferences tests that escapes and references are resolved
+func TestConvertToLexical_EscapesAndReferences(t *testing.T) {
+	nodes := convertForTest(t, "a \\* b &amp; c &#35; snake_case `x\\*y`\n")
+	inline := nodes[0].Children()
+	if len(inline) != 2 {
+		t.Fatalf("got %d inline nodes; want 2 (adjacent text merged): %v", len(inline), inline)
+	}
+	if got := inline[0].String("text"); got != "a * b & c # snake_case " {
+		t.Errorf("text = %q", got)
+	}
+	if got := inline[1].String("text"); got != `x\*y` {
+		t.Errorf("code span = %q; want it literal", got)
+	}
+}
+
+// TestConvertToLexical_MarkdownRoundTrip tests that Lexical survives a Markdown round trip
+func TestConvertToLexical_MarkdownRoundTrip(t *testing.T) {
+	text := `Use snake_case, *stars*, [brackets], <tags>, back\slash \* and Tom & Jerry`
+	doc := lexical.NewDocument(lexical.Element("paragraph", lexical.Text(text, 0)))
+	x, err := doc.String()
+	if err != nil {
+		t.Fatalf("String() error: %v", err)
+	}
+
+	markdown, err := FromLexicalString(x)
+	if err != nil {
+		t.Fatalf("FromLexicalString() error: %v", err)
+	}
+	got, err := ConvertToLexical(markdown)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	if got != x {
+		t.Errorf("round trip via %q:\ngot  %s\nwant %s", markdown, got, x)
+	}
+}
+
+// TestConvertToLexical_CodeBlockLanguage tests that fenced code keeps its language
+func TestConvertToLexical_CodeBlockLanguage(t *testing.T) {
+	nodes := convertForTest(t, "```go\nfmt.Println(\"hi\")\n```\n")
+	if len(nodes) != 1 || nodes[0].Type() != "codeblock" {
+		t.Fatalf("nodes = %v", nodes)
+	}
+	if nodes[0].String("language") != "go" || nodes[0].String("code") != "fmt.Println(\"hi\")\n" {
+		t.Errorf("codeblock = %v", nodes[0])
+	}
+}
+
+// TestConvertToLexical_NestedList tests nested and ordered lists
+func TestConvertToLexical_NestedList(t *testing.T) {
+	nodes := convertForTest(t, "3. one\n4. two\n   - a\n   - b\n")
+	if len(nodes) != 1 || nodes[0].Type() != "list" {
+		t.Fatalf("nodes = %v", nodes)
+	}
+	list := nodes[0]
+	if list.String("listType") != "number" || list.Int("start") != 3 {
+		t.Errorf("list = %v", list)
+	}
+
+	// one, two, and an item holding the nested list
+	items := list.Children()
+	if len(items) != 3 {
+		t.Fatalf("got %d items; want 3", len(items))
+	}
+	nested := items[2].Children()
+	if len(nested) != 1 || nested[0].Type() != "list" || len(nested[0].Children()) != 2 {
+		t.Errorf("nested item = %v", items[2])
+	}
+
+	// Renders back to nested HTML lists
+	html := lexical.RenderHTML(lexical.NewDocument(nodes...))
+	if !strings.Contains(html, "<ul><li>a</li><li>b</li></ul>") {
+		t.Errorf("rendered HTML = %s", html)
+	}
+}
+
+// TestConvertToLexical_ImageCard tests images with captions and links
+func TestConvertToLexical_ImageCard(t *testing.T) {
+	nodes := convertForTest(t, "![A chart](https://example.com/chart.png \"Sales by month\")\n\n[![Logo](https://example.com/logo.png)](https://example.com)\n")
+	if len(nodes) != 2 {
+		t.Fatalf("got %d nodes; want 2", len(nodes))
+	}
+	image := nodes[0]
+	if image.Type() != "image" || image.String("src") != "https://example.com/chart.png" ||
+		image.String("alt") != "A chart" || image.String("caption") != "Sales by month" {
+		t.Errorf("image = %v", image)
+	}
+	if nodes[1].String("href") != "https://example.com" {
+		t.Errorf("linked image = %v", nodes[1])
+	}
+}
+
+// TestConvertToLexical_CalloutAndBookmark tests GitHub alerts and lone autolinks
+func TestConvertToLexical_CalloutAndBookmark(t *testing.T) {
+	nodes := convertForTest(t, "> [!WARNING]\n> Back up **first**.\n\n<https://ghost.org>\n")
+	if len(nodes) != 2 {
+		t.Fatalf("got %d nodes; want 2", len(nodes))
+	}
+	callout := nodes[0]
+	if callout.Type() != "callout" || callout.String("backgroundColor") != "yellow" ||
+		callout.String("calloutText") != "<p>Back up <strong>first</strong>.</p>" {
+		t.Errorf("callout = %v", callout)
+	}
+	bookmark := nodes[1]
+	if bookmark.Type() != "bookmark" || bookmark.String("url") != "https://ghost.org" {
+		t.Errorf("bookmark = %v", bookmark)
+	}
+}
+
+// TestConvertToLexical_HTMLFallback tests that raw HTML and inline images become HTML cards
+func TestConvertToLexical_HTMLFallback(t *testing.T) {
+	nodes := convertForTest(t, "<div class=\"x\">raw</div>\n\nText with ![icon](i.png) inline\n\n---\n\n> quoted\n")
+	types := []string{}
+	for _, n := range nodes {
+		types = append(types, n.Type())
+	}
+	if strings.Join(types, ",") != "html,html,horizontalrule,quote" {
+		t.Errorf("types = %v", types)
+	}
+	if !strings.Contains(nodes[1].String("html"), `<img src="i.png" alt="icon">`) {
+		t.Errorf("inline image card = %v", nodes[1])
+	}
+}
+
+// TestConvertToLexicalDocument_CustomGoldmark tests conversion with a caller-provided goldmark instance
+func TestConvertToLexicalDocument_CustomGoldmark(t *testing.T) {
+	doc := ConvertToLexicalDocument(goldmark.New(), "plain\n")
+	if children := doc.Root.Children(); len(children) != 1 || children[0].Type() != "paragraph" {
+		t.Errorf("children = %v", children)
+	}
+}
+
+// TestConvertToLexicalWithOptions_GFM tests GFM content on the Lexical path
+func TestConvertToLexicalWithOptions_GFM(t *testing.T) {
+	opts, err := DefaultOptions().Apply(map[string]bool{"hard_wraps": true})
+	if err != nil {
+		t.Fatalf("Apply() error: %v", err)
+	}
+	got, err := ConvertToLexicalWithOptions("| A |\n|---|\n| 1 |\n\n- [x] done\n\nsee https://example.com\n\n~~old~~\nnew\n", opts)
+	if err != nil {
+		t.Fatalf("ConvertToLexicalWithOptions() error: %v", err)
+	}
+	for _, want := range []string{
+		`"type":"html"`, `\u003ctable\u003e`, // Tables are kept as HTML cards
+		`"text":"☑ done"`,
+		`"type":"link"`, `"url":"https://example.com"`, // Bare URLs are links, not bookmarks
+		`"format":4,"mode":"normal","style":"","text":"old"`,
+		`"type":"linebreak"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %s:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `"type":"bookmark"`) {
+		t.Errorf("bare URL became a bookmark:\n%s", got)
+	}
+}
+
+// TestConvertToLexical_InlineHTML tests that formatting tags become text formats
+// and other inline HTML is kept in an HTML card
+func TestConvertToLexical_InlineHTML(t *testing.T) {
+	nodes := convertForTest(t, "Press <u>this</u> <mark>now</mark>, H<sub>2</sub>O <sup>**2**</sup>\n\nA <span class=\"x\">styled</span> word\n")
+	if len(nodes) != 2 || nodes[0].Type() != "paragraph" {
+		t.Fatalf("nodes = %v", nodes)
+	}
+	var got []string
+	for _, text := range nodes[0].Children() {
+		got = append(got, fmt.Sprintf("%s:%d", text.String("text"), text.Int("format")))
+	}
+	want := []string{"Press :0", "this:8", " :0", "now:128", ", H:0", "2:32", "O :0", "2:65"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("texts = %v; want %v", got, want)
+	}
+
+	if nodes[1].Type() != "html" || nodes[1].String("html") != `<p>A <span class="x">styled</span> word</p>` {
+		t.Errorf("inline HTML card = %v; want the raw HTML kept", nodes[1])
+	}
+}
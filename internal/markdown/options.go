@@ -0,0 +1,142 @@
+/**
+ * Markdown renderer options
+ *
+ * Selects the goldmark extensions and renderer settings used for both the
+ * HTML and the Lexical conversion. GitHub-flavored Markdown (tables,
+ * strikethrough, task lists, autolinks), footnotes, and heading IDs are on
+ * by default; typographer, hard wraps, and raw HTML passthrough are off.
+ * Lexical conversion always keeps raw HTML (in HTML cards).
+ */
+package markdown
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// Options are the Markdown conversion settings
+type Options struct {
+	Tables        bool // GFM tables
+	Strikethrough bool // GFM ~~strikethrough~~
+	TaskLists     bool // GFM - [ ] task lists
+	Autolinks     bool // GFM bare URL autolinks
+	Footnotes     bool // [^1] footnotes
+	HeadingIDs    bool // id attributes on headings
+	Typographer   bool // Smart quotes, dashes, and ellipses
+	HardWraps     bool // Line breaks inside paragraphs are kept
+	Unsafe        bool // Raw HTML is passed through in rendered HTML (not used for Lexical)
+}
+
+// DefaultOptions returns the default settings
+func DefaultOptions() Options {
+	return Options{
+		Tables:        true,
+		Strikethrough: true,
+		TaskLists:     true,
+		Autolinks:     true,
+		Footnotes:     true,
+		HeadingIDs:    true,
+	}
+}
+
+// optionFields maps option names (config keys and front matter keys) to fields
+func (o *Options) optionFields() map[string]*bool {
+	return map[string]*bool{
+		"tables":        &o.Tables,
+		"strikethrough": &o.Strikethrough,
+		"tasklists":     &o.TaskLists,
+		"autolinks":     &o.Autolinks,
+		"footnotes":     &o.Footnotes,
+		"heading_ids":   &o.HeadingIDs,
+		"typographer":   &o.Typographer,
+		"hard_wraps":    &o.HardWraps,
+		"unsafe":        &o.Unsafe,
+	}
+}
+
+// OptionNames returns the option names in alphabetical order
+func OptionNames() []string {
+	var names []string
+	for name := range (&Options{}).optionFields() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the value of the named option
+func (o Options) Get(name string) (bool, error) {
+	field, ok := o.optionFields()[name]
+	if !ok {
+		return false, fmt.Errorf("unknown markdown option: %s (available: %s)", name, strings.Join(OptionNames(), ", "))
+	}
+	return *field, nil
+}
+
+// Set sets the named option
+func (o *Options) Set(name string, value bool) error {
+	field, ok := o.optionFields()[name]
+	if !ok {
+		return fmt.Errorf("unknown markdown option: %s (available: %s)", name, strings.Join(OptionNames(), ", "))
+	}
+	*field = value
+	return nil
+}
+
+// Apply returns a copy of the options with the given overrides applied
+func (o Options) Apply(overrides map[string]bool) (Options, error) {
+	for name, value := range overrides {
+		if err := o.Set(name, value); err != nil {
+			return o, err
+		}
+	}
+	return o, nil
+}
+
+// New creates a goldmark instance for the options (with Ghost card shortcodes)
+func (o Options) New() goldmark.Markdown {
+	extensions := []goldmark.Extender{Shortcodes}
+	if o.Tables {
+		extensions = append(extensions, extension.Table)
+	}
+	if o.Strikethrough {
+		extensions = append(extensions, extension.Strikethrough)
+	}
+	if o.TaskLists {
+		extensions = append(extensions, extension.TaskList)
+	}
+	if o.Autolinks {
+		extensions = append(extensions, extension.Linkify)
+	}
+	if o.Footnotes {
+		extensions = append(extensions, extension.Footnote)
+	}
+	if o.Typographer {
+		extensions = append(extensions, extension.Typographer)
+	}
+
+	var parserOptions []parser.Option
+	if o.HeadingIDs {
+		parserOptions = append(parserOptions, parser.WithAutoHeadingID())
+	}
+	var rendererOptions []renderer.Option
+	if o.HardWraps {
+		rendererOptions = append(rendererOptions, html.WithHardWraps())
+	}
+	if o.Unsafe {
+		rendererOptions = append(rendererOptions, html.WithUnsafe())
+	}
+
+	return goldmark.New(
+		goldmark.WithExtensions(extensions...),
+		goldmark.WithParserOptions(parserOptions...),
+		goldmark.WithRendererOptions(rendererOptions...),
+	)
+}
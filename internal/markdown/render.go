@@ -0,0 +1,384 @@
+/**
+ * Lexical to Markdown rendering
+ *
+ * Renders Ghost Lexical documents as Markdown that ConvertToLexical reads
+ * back: code blocks become fenced blocks, image captions become image
+ * titles, callouts become GitHub-style alerts, and bookmarks become lone
+ * autolinks. Other cards are written as shortcodes (:::toggle, ...) or raw
+ * HTML (html cards). A card is only written in such a form if it converts
+ * back to the same card; otherwise (email cards, galleries, images with
+ * sizes, ...) it is written as a :::card block holding the card JSON.
+ */
+package markdown
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// calloutAlerts maps callout background colors back to GitHub alert types
+var calloutAlerts = map[string]string{
+	"blue":   "NOTE",
+	"green":  "TIP",
+	"purple": "IMPORTANT",
+	"yellow": "WARNING",
+	"red":    "CAUTION",
+}
+
+// FromLexical renders a Lexical document as Markdown
+func FromLexical(doc *lexical.Document) string {
+	var blocks []string
+	for _, child := range doc.Root.Children() {
+		if block := lexicalBlock(child); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return joinBlocks(blocks)
+}
+
+// FromLexicalString parses a Lexical JSON string and renders it as Markdown
+func FromLexicalString(s string) (string, error) {
+	doc, err := lexical.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return FromLexical(doc), nil
+}
+
+// lexicalBlock renders a block-level node
+func lexicalBlock(n lexical.Node) string {
+	switch n.Type() {
+	case "paragraph":
+		return escapeLines(lexicalInline(n.Children()))
+	case "heading", "extended-heading":
+		level := 2
+		if tag := n.String("tag"); len(tag) == 2 && tag[1] >= '1' && tag[1] <= '6' {
+			level = int(tag[1] - '0')
+		}
+		return strings.Repeat("#", level) + " " + lexicalInline(n.Children())
+	case "quote", "extended-quote", "aside":
+		return quoteLines(escapeLines(lexicalInline(n.Children())))
+	case "list":
+		return lexicalList(n, "")
+	}
+
+	// Cards
+	if markdown := cardMarkdown(n); markdown != "" && convertsBack(markdown, n) {
+		return markdown
+	}
+	return rawCardMarkdown(n)
+}
+
+// cardMarkdown renders a card in its readable form ("" if it has none)
+func cardMarkdown(n lexical.Node) string {
+	switch n.Type() {
+	case "horizontalrule":
+		return "---"
+	case "codeblock":
+		return fencedCode(n.String("code"), n.String("language"))
+	case "image":
+		image := imageMarkdown(n.String("alt"), n.String("src"), HTMLText(n.String("caption")))
+		if href := n.String("href"); href != "" {
+			image = fmt.Sprintf("[%s](%s)", image, linkDestination(href))
+		}
+		return image
+	case "callout":
+		// Callouts in a GitHub alert style keep the alert syntax
+		alert, ok := calloutAlerts[n.String("backgroundColor")]
+		if ok && n.String("calloutEmoji") == calloutStyles[alert][0] {
+			return calloutMarkdown(alert, FromHTML(n.String("calloutText")))
+		}
+	case "bookmark":
+		// Bookmarks without metadata beyond the URL are lone autolinks
+		url, meta := n.String("url"), n.Map("metadata")
+		if url == "" {
+			return ""
+		}
+		title := meta.String("title")
+		if (title == "" || title == url) && meta.String("description") == "" && meta.String("thumbnail") == "" && n.String("caption") == "" {
+			return "<" + url + ">"
+		}
+	case "html":
+		return strings.TrimSpace(n.String("html"))
+	}
+
+	// Other cards are written as shortcodes
+	if shortcode, ok := shortcodeMarkdown(n, FromHTML); ok {
+		return shortcode
+	}
+	return ""
+}
+
+// cardParser converts card Markdown back as ConvertToLexical does
+var cardParser goldmark.Markdown = func() goldmark.Markdown {
+	opts := DefaultOptions()
+	opts.Unsafe = true
+	return opts.New()
+}()
+
+// convertsBack reports whether markdown converts back to exactly the card n
+func convertsBack(markdown string, n lexical.Node) bool {
+	nodes := convertLexical(cardParser, markdown, false).Root.Children()
+	return len(nodes) == 1 && sameNode(nodes[0], n)
+}
+
+// sameNode reports whether two nodes have the same JSON
+func sameNode(a, b lexical.Node) bool {
+	var values [2]interface{}
+	for i, n := range []lexical.Node{a, b} {
+		data, err := json.Marshal(n)
+		if err != nil || json.Unmarshal(data, &values[i]) != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(values[0], values[1])
+}
+
+// rawCardMarkdown renders a card as a :::card block holding its JSON
+func rawCardMarkdown(n lexical.Node) string {
+	data, err := json.MarshalIndent(n, "", "  ")
+	if err != nil {
+		data = []byte("{}")
+	}
+	return ":::" + rawCardName + "\n" + string(data) + "\n:::"
+}
+
+// lexicalList renders a (possibly nested) list with the given indentation
+func lexicalList(n lexical.Node, indent string) string {
+	ordered := n.String("listType") == "number" || n.String("tag") == "ol"
+	number := n.Int("start")
+	if number < 1 {
+		number = 1
+	}
+
+	var lines []string
+	markerWidth := 2
+	for _, item := range n.Children() {
+		var text []lexical.Node
+		for _, child := range item.Children() {
+			if child.Type() == "list" {
+				// Nested lists are indented to the content of the previous item
+				lines = append(lines, lexicalList(child, indent+strings.Repeat(" ", markerWidth)))
+				continue
+			}
+			text = append(text, child)
+		}
+		if len(text) == 0 {
+			continue
+		}
+
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		}
+		markerWidth = len(marker)
+		body := strings.ReplaceAll(escapeLines(lexicalInline(text)), "\n", "\n"+indent+strings.Repeat(" ", markerWidth))
+		lines = append(lines, indent+marker+body)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// lexicalInline renders inline nodes (text, links, line breaks)
+func lexicalInline(nodes []lexical.Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Type() {
+		case "text", "extended-text":
+			b.WriteString(formatText(n.String("text"), n.Int("format")))
+		case "link":
+			b.WriteString(linkMarkdown(lexicalInline(n.Children()), n.String("url"), n.String("title")))
+		case "linebreak":
+			b.WriteString("\\\n")
+		case "tab":
+			b.WriteString("\t")
+		default:
+			b.WriteString(lexicalInline(n.Children()))
+		}
+	}
+	return b.String()
+}
+
+// formatText escapes text and wraps it in the markers for its format bit flags
+//
+// Underline, highlight, subscript, and superscript are written as <u>, <mark>,
+// <sub>, and <sup>, which the Markdown importer reads back as formats.
+func formatText(text string, format int) string {
+	if text == "" {
+		return ""
+	}
+
+	var lead, out, trail string
+	if format&lexical.FormatCode != 0 {
+		out = codeSpan(text)
+	} else {
+		// Keep surrounding whitespace outside the markers ("**bold **" is not bold)
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" {
+			return text
+		}
+		lead = text[:strings.Index(text, trimmed)]
+		trail = text[len(lead)+len(trimmed):]
+		out = escapeText(trimmed)
+	}
+	wrappers := []struct {
+		flag        int
+		open, close string
+	}{
+		{lexical.FormatHighlight, "<mark>", "</mark>"},
+		{lexical.FormatSuperscript, "<sup>", "</sup>"},
+		{lexical.FormatSubscript, "<sub>", "</sub>"},
+		{lexical.FormatUnderline, "<u>", "</u>"},
+		{lexical.FormatStrikethrough, "~~", "~~"},
+		{lexical.FormatItalic, "*", "*"},
+		{lexical.FormatBold, "**", "**"},
+	}
+	for _, w := range wrappers {
+		if format&w.flag != 0 {
+			out = w.open + out + w.close
+		}
+	}
+	return lead + out + trail
+}
+
+// calloutMarkdown renders a callout as a GitHub-style alert
+func calloutMarkdown(alert, body string) string {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "> [!" + alert + "]"
+	}
+	return "> [!" + alert + "]\n" + quoteLines(body)
+}
+
+// imageMarkdown renders an image with an optional title (caption)
+func imageMarkdown(alt, src, title string) string {
+	if title != "" {
+		return fmt.Sprintf("![%s](%s %s)", escapeText(alt), linkDestination(src), linkTitle(title))
+	}
+	return fmt.Sprintf("![%s](%s)", escapeText(alt), linkDestination(src))
+}
+
+// linkMarkdown renders a link with an optional title
+func linkMarkdown(label, url, title string) string {
+	if title != "" {
+		return fmt.Sprintf("[%s](%s %s)", label, linkDestination(url), linkTitle(title))
+	}
+	return fmt.Sprintf("[%s](%s)", label, linkDestination(url))
+}
+
+// linkDestination escapes a link destination, wrapping destinations
+// containing spaces, parentheses, or angle brackets in <...>
+func linkDestination(url string) string {
+	url = escapeReferences(strings.ReplaceAll(url, `\`, `\\`))
+	if strings.ContainsAny(url, " ()<>") {
+		return "<" + strings.NewReplacer("<", `\<`, ">", `\>`).Replace(url) + ">"
+	}
+	return url
+}
+
+// linkTitle quotes and escapes a link or image title
+func linkTitle(title string) string {
+	return `"` + escapeReferences(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(title)) + `"`
+}
+
+// fencedCode renders a fenced code block, using a fence longer than any backtick run in the code
+func fencedCode(code, language string) string {
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	return fence + language + "\n" + strings.TrimRight(code, "\n") + "\n" + fence
+}
+
+// codeSpan renders inline code, using a delimiter longer than any backtick run in the text
+func codeSpan(text string) string {
+	delim := "`"
+	for strings.Contains(text, delim) {
+		delim += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return delim + " " + text + " " + delim
+	}
+	return delim + text + delim
+}
+
+// quoteLines prefixes every line with "> "
+func quoteLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownEscaper escapes characters with inline meaning in Markdown
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `~`, `\~`,
+)
+
+// referencePattern matches text that would be read as a character reference
+var referencePattern = regexp.MustCompile(`&(#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+
+// escapeText escapes inline Markdown syntax in plain text
+//
+// The Markdown importer resolves escapes and references, so the escaped text
+// converts back to the same text.
+func escapeText(text string) string {
+	return escapeReferences(markdownEscaper.Replace(text))
+}
+
+// escapeReferences escapes text that would be read as a character reference
+func escapeReferences(text string) string {
+	return referencePattern.ReplaceAllString(text, `\$0`)
+}
+
+// lineStartPattern matches a line that would start a block (heading, quote,
+// list, setext underline, shortcode, ...)
+var lineStartPattern = regexp.MustCompile(`^(#{1,6}(\s|$)|>|[-+](\s|$)|\d{1,9}[.)](\s|$)|=+\s*$|-+\s*$|:{3})`)
+
+// escapeLines escapes the start of every line of a paragraph, list item, or
+// quote, so that no line parses as another block
+func escapeLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = escapeLineStart(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escapeLineStart escapes a line that would otherwise parse as another block
+//
+// Leading whitespace (an indented code block, or dropped) is kept by writing
+// its first character as a character reference.
+func escapeLineStart(line string) string {
+	switch {
+	case strings.HasPrefix(line, " "):
+		return "&#32;" + line[1:]
+	case strings.HasPrefix(line, "\t"):
+		return "&#9;" + line[1:]
+	case lineStartPattern.MatchString(line):
+		if i := strings.IndexAny(line, "#>-+=.):"); i >= 0 {
+			return line[:i] + `\` + line[i:]
+		}
+	}
+	return line
+}
+
+// joinBlocks separates blocks with blank lines and ends with a newline
+func joinBlocks(blocks []string) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
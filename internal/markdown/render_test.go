@@ -0,0 +1,274 @@
+/**
+ * Test code for Lexical/HTML→Markdown rendering
+ */
+package markdown
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// TestFromLexical_RoundTrip tests that Markdown survives a Lexical round trip
+func TestFromLexical_RoundTrip(t *testing.T) {
+	source := strings.Join([]string{
+		"## Title",
+		"Hello **bold** *italic* `code` [link](https://example.com)",
+		"1. one\n2. two\n   - nested\n   - items",
+		"```go\nfmt.Println(\"hi\")\n```",
+		"![Chart](https://example.com/chart.png \"Sales by month\")",
+		"> [!TIP]\n> Use **snapshots**.",
+		"<https://ghost.org>",
+		"---",
+		"> quoted",
+	}, "\n\n") + "\n"
+
+	lexicalJSON, err := ConvertToLexical(source)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	got, err := FromLexicalString(lexicalJSON)
+	if err != nil {
+		t.Fatalf("FromLexicalString() error: %v", err)
+	}
+	if got != source {
+		t.Errorf("round trip mismatch.\nExpected:\n%s\nActual:\n%s", source, got)
+	}
+}
+
+// TestFromLexicalString_TextRoundTrip tests that escaped text converts back to the same Lexical
+func TestFromLexicalString_TextRoundTrip(t *testing.T) {
+	for _, text := range []string{
+		`snake_case and *not emphasis* or [not a link](x)`,
+		`a < b, back\slash \* and ` + "`ticks`",
+		`Tom &amp; Jerry &copy; &#35;1 & co`,
+		`~~not struck~~ ~tilde~`,
+	} {
+		doc := lexical.NewDocument(lexical.Element("paragraph", lexical.Text(text, 0)))
+		x, err := doc.String()
+		if err != nil {
+			t.Fatalf("String() error: %v", err)
+		}
+
+		markdown, err := FromLexicalString(x)
+		if err != nil {
+			t.Fatalf("FromLexicalString() error: %v", err)
+		}
+		got, err := ConvertToLexical(markdown)
+		if err != nil {
+			t.Fatalf("ConvertToLexical() error: %v", err)
+		}
+		if got != x {
+			t.Errorf("round trip of %q via %q:\ngot  %s\nwant %s", text, markdown, got, x)
+		}
+	}
+}
+
+// TestFromLexicalString_FormatRoundTrip tests that every text format survives a round trip
+func TestFromLexicalString_FormatRoundTrip(t *testing.T) {
+	formats := []int{
+		lexical.FormatBold, lexical.FormatItalic, lexical.FormatStrikethrough, lexical.FormatUnderline,
+		lexical.FormatCode, lexical.FormatSubscript, lexical.FormatSuperscript, lexical.FormatHighlight,
+		lexical.FormatBold | lexical.FormatUnderline, lexical.FormatItalic | lexical.FormatHighlight,
+		lexical.FormatCode | lexical.FormatBold, lexical.FormatStrikethrough | lexical.FormatUnderline,
+	}
+	for _, format := range formats {
+		doc := lexical.NewDocument(lexical.Element("paragraph",
+			lexical.Text("Press ", 0), lexical.Text("this", format), lexical.Text(" now", 0)))
+		x, err := doc.String()
+		if err != nil {
+			t.Fatalf("String() error: %v", err)
+		}
+
+		markdown, err := FromLexicalString(x)
+		if err != nil {
+			t.Fatalf("FromLexicalString() error: %v", err)
+		}
+		got, err := ConvertToLexical(markdown)
+		if err != nil {
+			t.Fatalf("ConvertToLexical() error: %v", err)
+		}
+		if got != x {
+			t.Errorf("round trip of format %d via %q:\ngot  %s\nwant %s", format, markdown, got, x)
+		}
+	}
+}
+
+// TestFromLexicalString_LineStartRoundTrip tests that lines that look like
+// block syntax stay text in paragraphs, list items, and after line breaks
+func TestFromLexicalString_LineStartRoundTrip(t *testing.T) {
+	br := lexical.Node{"type": "linebreak", "version": 1}
+	list := lexical.Element("list",
+		lexical.Element("listitem", lexical.Text("1. step one", 0)),
+		lexical.Element("listitem", lexical.Text("# hash", 0)),
+		lexical.Element("listitem", lexical.Text("> quote", 0), br, lexical.Text("- dash", 0)))
+	list["listType"], list["tag"], list["start"] = "bullet", "ul", 1
+	for i, child := range list.Children() {
+		child["value"] = i + 1
+	}
+
+	doc := lexical.NewDocument(
+		lexical.Element("paragraph", lexical.Text("first", 0), br, lexical.Text("- not a list", 0), br, lexical.Text("2) nor this", 0)),
+		lexical.Element("paragraph", lexical.Text("    indented", 0), br, lexical.Text("\ttabbed", 0)),
+		lexical.Element("paragraph", lexical.Text("title", 0), br, lexical.Text("-", 0), br, lexical.Text("===", 0)),
+		lexical.Element("paragraph", lexical.Text(":::toggle", 0), br, lexical.Text("## heading", 0)),
+		list,
+	)
+	x, err := doc.String()
+	if err != nil {
+		t.Fatalf("String() error: %v", err)
+	}
+
+	markdown, err := FromLexicalString(x)
+	if err != nil {
+		t.Fatalf("FromLexicalString() error: %v", err)
+	}
+	got, err := ConvertToLexical(markdown)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	if got != x {
+		t.Errorf("round trip via:\n%s\ngot  %s\nwant %s", markdown, got, x)
+	}
+}
+
+// TestFromLexicalString_LinkRoundTrip tests that link destinations and titles survive a round trip
+func TestFromLexicalString_LinkRoundTrip(t *testing.T) {
+	link := lexical.Element("link", lexical.Text("docs_page", 0))
+	link["url"] = `https://example.com/a_(b)?x=1&copy;`
+	link["rel"], link["target"] = nil, nil
+	link["title"] = `Say "hi" \ &amp; bye`
+	doc := lexical.NewDocument(lexical.Element("paragraph", link))
+	x, err := doc.String()
+	if err != nil {
+		t.Fatalf("String() error: %v", err)
+	}
+
+	markdown, err := FromLexicalString(x)
+	if err != nil {
+		t.Fatalf("FromLexicalString() error: %v", err)
+	}
+	got, err := ConvertToLexical(markdown)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	if got != x {
+		t.Errorf("round trip via %q:\ngot  %s\nwant %s", markdown, got, x)
+	}
+}
+
+// TestFromLexicalString_CardRoundTrip tests that every Ghost card converts back unchanged
+func TestFromLexicalString_CardRoundTrip(t *testing.T) {
+	cards := map[string]string{
+		"image":          `{"type":"image","version":1,"src":"https://example.com/a.jpg","width":1200,"height":800,"title":"","alt":"A","caption":"Cap","cardWidth":"wide","href":""}`,
+		"image-markdown": `{"type":"image","version":1,"src":"https://example.com/a.jpg","width":null,"height":null,"title":"","alt":"A","caption":"","cardWidth":"regular","href":""}`,
+		"gallery":        `{"type":"gallery","version":1,"images":[{"row":0,"src":"https://example.com/1.jpg","width":800,"height":600,"fileName":"1.jpg","alt":"","caption":""}],"caption":"Trip"}`,
+		"codeblock":      `{"type":"codeblock","version":1,"code":"fmt.Println()\n","language":"go","caption":""}`,
+		"codeblock-cap":  `{"type":"codeblock","version":1,"code":"x","language":"","caption":"Example"}`,
+		"html":           `{"type":"html","version":1,"html":"<div class=\"x\">raw</div>"}`,
+		"html-fragment":  `{"type":"html","version":1,"html":"text <b>bold</b>\n\nmore"}`,
+		"markdown":       `{"type":"markdown","version":1,"markdown":"# Old **card**"}`,
+		"callout":        `{"type":"callout","version":1,"calloutText":"<p>Hot</p>","calloutEmoji":"🔥","backgroundColor":"grey"}`,
+		"bookmark":       `{"type":"bookmark","version":1,"url":"https://ghost.org","metadata":{"icon":"https://ghost.org/i.png","title":"Ghost","description":"d","author":"","publisher":"Ghost","thumbnail":""},"caption":""}`,
+		"embed":          `{"type":"embed","version":1,"url":"https://youtu.be/x","embedType":"video","html":"<iframe></iframe>","metadata":{"title":"Video"},"caption":""}`,
+		"button":         `{"type":"button","version":1,"buttonText":"Go","alignment":"center","buttonUrl":"https://example.com"}`,
+		"toggle":         `{"type":"toggle","version":1,"heading":"Q","content":"<p>A</p>"}`,
+		"video":          `{"type":"video","version":1,"src":"https://example.com/v.mp4","caption":"","fileName":"v.mp4","mimeType":"video/mp4","width":640,"height":480,"duration":3.5,"thumbnailSrc":"","loop":false}`,
+		"audio":          `{"type":"audio","version":1,"src":"https://example.com/a.mp3","title":"Ep 1","duration":60,"mimeType":"audio/mpeg","thumbnailSrc":""}`,
+		"file":           `{"type":"file","version":1,"src":"https://example.com/f.pdf","fileTitle":"Guide","fileCaption":"","fileName":"f.pdf","fileSize":1024}`,
+		"paywall":        `{"type":"paywall","version":1}`,
+		"email":          `{"type":"email","version":1,"html":"<p>Hey {first_name}, thanks for subscribing</p>"}`,
+		"email-cta":      `{"type":"email-cta","version":1,"html":"<p>Upgrade</p>","segment":"status:free","alignment":"left","showButton":true,"showDividers":true,"buttonText":"Go","buttonUrl":"https://example.com"}`,
+		"header":         `{"type":"header","version":2,"size":"small","style":"dark","buttonEnabled":false,"buttonUrl":"","buttonText":"","header":"<span>Hi</span>","subheader":"","backgroundImageSrc":""}`,
+		"product":        `{"type":"product","version":1,"productImageSrc":"","productTitle":"<span>Book</span>","productDescription":"<p>Good</p>","productRatingEnabled":true,"productStarRating":5,"productButtonEnabled":false,"productButton":"","productUrl":""}`,
+		"signup":         `{"type":"signup","version":1,"alignment":"left","backgroundColor":"#F0F0F0","buttonColor":"accent","buttonText":"Subscribe","header":"Join","labels":[],"layout":"wide","subheader":"","disclaimer":"","backgroundImageSrc":"","textColor":"#000000","buttonTextColor":"#FFFFFF","backgroundSize":"cover","swapped":false}`,
+		"horizontalrule": `{"type":"horizontalrule","version":1}`,
+		"unknown":        `{"type":"transistor","version":1,"accentColor":"#15171A"}`,
+	}
+
+	paragraph := func(text string) string {
+		data, err := json.Marshal(lexical.Element("paragraph", lexical.Text(text, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	for name, card := range cards {
+		t.Run(name, func(t *testing.T) {
+			// The card sits between paragraphs, as in a post
+			x := `{"root":{"type":"root","version":1,"direction":"ltr","format":"","indent":0,"children":[` +
+				paragraph("before") + "," + card + "," + paragraph("after") + `]}}`
+
+			markdown, err := FromLexicalString(x)
+			if err != nil {
+				t.Fatalf("FromLexicalString() error: %v", err)
+			}
+			got, err := ConvertToLexical(markdown)
+			if err != nil {
+				t.Fatalf("ConvertToLexical() error: %v", err)
+			}
+			var want, have interface{}
+			if err := json.Unmarshal([]byte(x), &want); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(got), &have); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(have, want) {
+				t.Errorf("round trip via:\n%s\ngot  %s\nwant %s", markdown, got, x)
+			}
+		})
+	}
+}
+
+// TestFromLexicalString_EscapesAndCards tests escaping and cards kept as card JSON
+func TestFromLexicalString_EscapesAndCards(t *testing.T) {
+	input := `{"root":{"type":"root","children":[
+		{"type":"paragraph","children":[{"type":"extended-text","text":"# not a heading *or* [link]","format":0}]},
+		{"type":"paragraph","children":[{"type":"extended-text","text":"bold ","format":1},{"type":"extended-text","text":"text","format":0}]},
+		{"type":"video","src":"https://example.com/clip.mp4"}
+	]}}`
+
+	got, err := FromLexicalString(input)
+	if err != nil {
+		t.Fatalf("FromLexicalString() error: %v", err)
+	}
+	for _, want := range []string{
+		`\# not a heading \*or\* \[link\]`,
+		"**bold** text",
+		":::card\n{\n  \"src\": \"https://example.com/clip.mp4\",\n  \"type\": \"video\"\n}\n:::",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+// TestFromHTML tests HTML to Markdown conversion including Ghost cards
+func TestFromHTML(t *testing.T) {
+	input := `<h2>Intro</h2><p>Some <strong>bold</strong> and <a href="https://example.com">a link</a>.<br>Next line</p>` +
+		`<ul><li>one<ul><li>inner</li></ul></li><li>two</li></ul>` +
+		`<pre><code class="language-js">if (a < b) {}</code></pre>` +
+		`<figure class="kg-card kg-image-card kg-card-hascaption"><img src="https://example.com/a.png" class="kg-image" alt="A"><figcaption><span>My caption</span></figcaption></figure>` +
+		`<div class="kg-card kg-callout-card kg-callout-card-yellow"><div class="kg-callout-emoji">⚠️</div><div class="kg-callout-text">Careful &amp; slow</div></div>` +
+		`<figure class="kg-card kg-bookmark-card"><a class="kg-bookmark-container" href="https://ghost.org/"><div class="kg-bookmark-title">Ghost</div></a></figure>` +
+		`<!--kg-card-begin: html--><table><tr><td>x</td></tr></table><!--kg-card-end: html-->`
+
+	want := strings.Join([]string{
+		"## Intro",
+		"Some **bold** and [a link](https://example.com).\\\nNext line",
+		"- one\n  - inner\n- two",
+		"```js\nif (a < b) {}\n```",
+		"![A](https://example.com/a.png \"My caption\")",
+		"> [!WARNING]\n> Careful & slow",
+		"<https://ghost.org/>",
+		"<table><tr><td>x</td></tr></table>",
+	}, "\n\n") + "\n"
+
+	if got := FromHTML(input); got != want {
+		t.Errorf("FromHTML() mismatch.\nExpected:\n%s\nActual:\n%s", want, got)
+	}
+}
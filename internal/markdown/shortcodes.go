@@ -0,0 +1,446 @@
+/**
+ * Ghost card shortcodes
+ *
+ * A goldmark extension for Ghost cards that plain Markdown cannot express.
+ * A card is a fenced block of three or more colons with the card name and
+ * optional attributes; the content up to the closing fence is the card body:
+ *
+ *	:::callout{emoji="💡" color="green"}
+ *	Markdown **body**
+ *	:::
+ *
+ * Cards are nested by giving the outer card a longer fence (::::toggle ...
+ * ::::). A :::card block holds any card as its Lexical JSON. On the Lexical
+ * path shortcodes become card nodes; on the HTML path they are rendered as
+ * Ghost card HTML.
+ */
+package markdown
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// shortcodeAttr maps a shortcode attribute to a card field
+type shortcodeAttr struct {
+	name  string
+	field string // Card field ("metadata.title" for nested fields)
+}
+
+// shortcodeSpec describes how a shortcode maps to a Lexical card
+type shortcodeSpec struct {
+	attrs    []shortcodeAttr        // Attributes in output order
+	body     string                 // Card field holding the body ("" if the card has none)
+	rawBody  bool                   // Body is raw HTML instead of Markdown
+	switches map[string]string      // Boolean card field → field whose value enables it
+	defaults map[string]interface{} // Field values used when the attribute is missing
+}
+
+// shortcodeSpecs are the supported shortcodes by card (and shortcode) name
+var shortcodeSpecs = map[string]shortcodeSpec{
+	"callout": {
+		attrs:    []shortcodeAttr{{"emoji", "calloutEmoji"}, {"color", "backgroundColor"}},
+		body:     "calloutText",
+		defaults: map[string]interface{}{"calloutEmoji": "💡", "backgroundColor": "grey"},
+	},
+	"toggle": {
+		attrs: []shortcodeAttr{{"heading", "heading"}},
+		body:  "content",
+	},
+	"button": {
+		attrs:    []shortcodeAttr{{"text", "buttonText"}, {"url", "buttonUrl"}, {"align", "alignment"}},
+		defaults: map[string]interface{}{"alignment": "center"},
+	},
+	"bookmark": {
+		attrs: []shortcodeAttr{
+			{"url", "url"}, {"title", "metadata.title"}, {"description", "metadata.description"},
+			{"thumbnail", "metadata.thumbnail"}, {"caption", "caption"},
+		},
+	},
+	"embed": {
+		attrs:    []shortcodeAttr{{"url", "url"}, {"type", "embedType"}, {"caption", "caption"}},
+		body:     "html",
+		rawBody:  true,
+		defaults: map[string]interface{}{"embedType": "", "metadata": map[string]interface{}{}},
+	},
+	"header": {
+		attrs: []shortcodeAttr{
+			{"header", "header"}, {"subheader", "subheader"}, {"size", "size"}, {"style", "style"},
+			{"image", "backgroundImageSrc"}, {"button-text", "buttonText"}, {"button-url", "buttonUrl"},
+		},
+		switches: map[string]string{"buttonEnabled": "buttonUrl"},
+		defaults: map[string]interface{}{"size": "small", "style": "dark"},
+	},
+	"product": {
+		attrs: []shortcodeAttr{
+			{"title", "productTitle"}, {"image", "productImageSrc"},
+			{"button-text", "productButton"}, {"button-url", "productUrl"},
+		},
+		body:     "productDescription",
+		switches: map[string]string{"productButtonEnabled": "productUrl"},
+	},
+	"signup": {
+		attrs: []shortcodeAttr{
+			{"header", "header"}, {"subheader", "subheader"}, {"button-text", "buttonText"},
+			{"disclaimer", "disclaimer"}, {"layout", "layout"}, {"color", "backgroundColor"},
+			{"image", "backgroundImageSrc"},
+		},
+		defaults: map[string]interface{}{"buttonText": "Subscribe", "layout": "wide"},
+	},
+}
+
+// rawCardName is the shortcode holding a card as Lexical JSON
+const rawCardName = "card"
+
+var (
+	// Opening fence: :::name{key="value" ...}
+	shortcodeOpenPattern = regexp.MustCompile(`^(:{3,})[ \t]*([a-z]+)[ \t]*(?:\{(.*)\})?[ \t]*$`)
+	// Attribute inside the braces: key="value" or key=value
+	shortcodeAttrPattern = regexp.MustCompile(`([A-Za-z][\w-]*)\s*=\s*("(?:[^"\\]|\\.)*"|[^\s}]+)`)
+)
+
+// KindShortcode is the AST node kind of shortcode blocks
+var KindShortcode = ast.NewNodeKind("Shortcode")
+
+// Shortcode is a Ghost card shortcode block
+type Shortcode struct {
+	ast.BaseBlock
+	Name  string            // Card name
+	Attrs map[string]string // Shortcode attributes
+	Fence int               // Number of colons in the opening fence
+	Body  *text.Segments    // Raw body lines
+}
+
+// Kind implements ast.Node
+func (n *Shortcode) Kind() ast.NodeKind {
+	return KindShortcode
+}
+
+// Dump implements ast.Node
+func (n *Shortcode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Name": n.Name}, nil)
+}
+
+// shortcodeParser parses shortcode blocks
+type shortcodeParser struct{}
+
+// Trigger implements parser.BlockParser
+func (p *shortcodeParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+// Open implements parser.BlockParser
+func (p *shortcodeParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	match := shortcodeOpenPattern.FindSubmatch(bytes.TrimRight(line[pos:], "\r\n"))
+	if match == nil {
+		return nil, parser.NoChildren
+	}
+	name := string(match[2])
+	if _, ok := shortcodeSpecs[name]; !ok && name != rawCardName {
+		return nil, parser.NoChildren
+	}
+
+	node := &Shortcode{
+		Name:  name,
+		Attrs: parseShortcodeAttrs(string(match[3])),
+		Fence: len(match[1]),
+		Body:  text.NewSegments(),
+	}
+	reader.Advance(segment.Len() - trailingNewline(line))
+	return node, parser.HasChildren
+}
+
+// Continue implements parser.BlockParser
+func (p *shortcodeParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*Shortcode)
+	line, segment := reader.PeekLine()
+
+	// A line of at least as many colons closes the card
+	if w, pos := util.IndentWidth(line, reader.LineOffset()); w < 4 {
+		fence := bytes.TrimRight(line[pos:], " \t\r\n")
+		if len(fence) >= n.Fence && len(bytes.Trim(fence, ":")) == 0 {
+			reader.Advance(segment.Len() - trailingNewline(line))
+			return parser.Close
+		}
+	}
+
+	n.Body.Append(segment)
+	return parser.Continue | parser.HasChildren
+}
+
+// Close implements parser.BlockParser
+func (p *shortcodeParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+// CanInterruptParagraph implements parser.BlockParser
+func (p *shortcodeParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser
+func (p *shortcodeParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// trailingNewline returns 1 if line ends with a newline
+func trailingNewline(line []byte) int {
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		return 1
+	}
+	return 0
+}
+
+// parseShortcodeAttrs parses the attributes between the braces
+func parseShortcodeAttrs(s string) map[string]string {
+	attrs := map[string]string{}
+	for _, match := range shortcodeAttrPattern.FindAllStringSubmatch(s, -1) {
+		value := match[2]
+		if strings.HasPrefix(value, `"`) {
+			value = shortcodeUnescaper.Replace(value[1 : len(value)-1])
+		}
+		attrs[match[1]] = value
+	}
+	return attrs
+}
+
+var (
+	shortcodeEscaper   = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", " ")
+	shortcodeUnescaper = strings.NewReplacer(`\\`, `\`, `\"`, `"`)
+)
+
+// shortcodeCard converts a shortcode to a Lexical card
+//
+// renderHTML renders a body node to HTML.
+func shortcodeCard(n *Shortcode, source []byte, renderHTML func(ast.Node) string) lexical.Node {
+	if n.Name == rawCardName {
+		return rawCard(n, source)
+	}
+	spec := shortcodeSpecs[n.Name]
+	fields := map[string]interface{}{}
+	for field, value := range spec.defaults {
+		fields[field] = value
+	}
+	for _, attr := range spec.attrs {
+		if value, ok := n.Attrs[attr.name]; ok {
+			setCardField(fields, attr.field, value)
+		}
+	}
+
+	if spec.body != "" {
+		var body strings.Builder
+		if spec.rawBody {
+			for i := 0; i < n.Body.Len(); i++ {
+				segment := n.Body.At(i)
+				body.Write(segment.Value(source))
+			}
+		} else {
+			for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+				body.WriteString(renderHTML(child))
+			}
+		}
+		fields[spec.body] = strings.TrimSpace(body.String())
+	}
+	for flag, field := range spec.switches {
+		value, _ := fields[field].(string)
+		fields[flag] = value != ""
+	}
+
+	if n.Name == "bookmark" {
+		url, _ := fields["url"].(string)
+		meta, _ := fields["metadata"].(map[string]interface{})
+		caption, _ := fields["caption"].(string)
+		return bookmarkCard(url, stringField(meta, "title"), stringField(meta, "description"), stringField(meta, "thumbnail"), caption)
+	}
+	return lexical.Card(n.Name, fields)
+}
+
+// rawCard parses the Lexical JSON of a :::card block
+//
+// A body that is not a card is kept as a JSON code block.
+func rawCard(n *Shortcode, source []byte) lexical.Node {
+	var body bytes.Buffer
+	for i := 0; i < n.Body.Len(); i++ {
+		segment := n.Body.At(i)
+		body.Write(segment.Value(source))
+	}
+	var card lexical.Node
+	if err := json.Unmarshal(body.Bytes(), &card); err != nil || card.Type() == "" {
+		return lexical.Card("codeblock", map[string]interface{}{"code": body.String(), "language": "json", "caption": ""})
+	}
+	return card
+}
+
+// setCardField sets a (possibly nested, dot-separated) card field
+func setCardField(fields map[string]interface{}, field, value string) {
+	parent, key, nested := strings.Cut(field, ".")
+	if !nested {
+		fields[field] = value
+		return
+	}
+	child, ok := fields[parent].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		fields[parent] = child
+	}
+	child[key] = value
+}
+
+// stringField returns a string value of a map (empty if missing)
+func stringField(m map[string]interface{}, key string) string {
+	value, _ := m[key].(string)
+	return value
+}
+
+// bookmarkCard creates a bookmark card (the title defaults to the URL)
+func bookmarkCard(url, title, description, thumbnail, caption string) lexical.Node {
+	if title == "" {
+		title = url
+	}
+	return lexical.Card("bookmark", map[string]interface{}{
+		"url": url,
+		"metadata": map[string]interface{}{
+			"url":         url,
+			"title":       title,
+			"description": description,
+			"icon":        "",
+			"thumbnail":   thumbnail,
+			"publisher":   "",
+			"author":      "",
+		},
+		"caption": caption,
+	})
+}
+
+// shortcodeMarkdown renders a card as a shortcode
+//
+// Returns false for cards without a shortcode. bodyMarkdown converts an
+// HTML body field to Markdown.
+func shortcodeMarkdown(n lexical.Node, bodyMarkdown func(string) string) (string, bool) {
+	spec, ok := shortcodeSpecs[n.Type()]
+	if !ok {
+		return "", false
+	}
+
+	// Attributes (values equal to the defaults are left out)
+	var attrs []string
+	for _, attr := range spec.attrs {
+		var value string
+		if parent, key, nested := strings.Cut(attr.field, "."); nested {
+			value = n.Map(parent).String(key)
+			if attr.field == "metadata.title" && value == n.String("url") {
+				continue
+			}
+		} else {
+			value = n.String(attr.field)
+		}
+		if value == "" || value == spec.defaults[attr.field] {
+			continue
+		}
+		if disabled := switchedOff(n, spec, attr.field); disabled {
+			continue
+		}
+		attrs = append(attrs, attr.name+`="`+shortcodeEscaper.Replace(value)+`"`)
+	}
+
+	// Body
+	var body string
+	if spec.body != "" {
+		body = n.String(spec.body)
+		if !spec.rawBody {
+			body = bodyMarkdown(body)
+		}
+		body = strings.TrimSpace(body)
+	}
+
+	// The fence must be longer than any fence inside the body
+	fence := ":::"
+	for hasLinePrefix(body, fence) {
+		fence += ":"
+	}
+
+	open := fence + n.Type()
+	if len(attrs) > 0 {
+		open += "{" + strings.Join(attrs, " ") + "}"
+	}
+	if body == "" {
+		return open + "\n" + fence, true
+	}
+	return open + "\n" + body + "\n" + fence, true
+}
+
+// switchedOff reports whether field is disabled by its boolean switch
+func switchedOff(n lexical.Node, spec shortcodeSpec, field string) bool {
+	for flag, source := range spec.switches {
+		if source == field && !n.Bool(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLinePrefix reports whether any line of s starts with prefix
+func hasLinePrefix(s, prefix string) bool {
+	for _, line := range strings.Split(s, "\n") {
+		if strings.HasPrefix(strings.TrimLeft(line, " "), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortcodeHTMLRenderer renders shortcodes as Ghost card HTML
+type shortcodeHTMLRenderer struct {
+	md goldmark.Markdown
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (r *shortcodeHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindShortcode, r.render)
+}
+
+// render renders a shortcode through its Lexical card
+func (r *shortcodeHTMLRenderer) render(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	card := shortcodeCard(node.(*Shortcode), source, func(child ast.Node) string {
+		var buf bytes.Buffer
+		if err := r.md.Renderer().Render(&buf, source, child); err != nil {
+			return ""
+		}
+		return buf.String()
+	})
+	_, _ = w.WriteString(lexical.RenderHTML(lexical.NewDocument(card)) + "\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// shortcodes is the goldmark extension for Ghost card shortcodes
+type shortcodes struct{}
+
+// Shortcodes is a goldmark extension that parses Ghost card shortcodes
+var Shortcodes goldmark.Extender = &shortcodes{}
+
+// Extend implements goldmark.Extender
+func (e *shortcodes) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithBlockParsers(
+		util.Prioritized(&shortcodeParser{}, 90),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&shortcodeHTMLRenderer{md: m}, 500),
+	))
+}
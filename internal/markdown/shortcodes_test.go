@@ -0,0 +1,114 @@
+/**
+ * Test code for Ghost card shortcodes
+ */
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mtane0412/ghocli/internal/lexical"
+)
+
+// shortcodeSource is Markdown with every supported shortcode (in canonical form)
+var shortcodeSource = strings.Join([]string{
+	"::::toggle{heading=\"FAQ \\\"one\\\"\"}\nAnswer *here*\n\n:::callout{emoji=\"🔥\" color=\"red\"}\nHot\n:::\n::::",
+	":::button{text=\"Go\" url=\"https://example.com\"}\n:::",
+	":::embed{url=\"https://youtube.com/watch?v=1\" type=\"video\"}\n<iframe src=\"https://youtube.com/embed/1\"></iframe>\n:::",
+	":::bookmark{url=\"https://ghost.org\" title=\"Ghost\" description=\"Publishing\"}\n:::",
+	":::header{header=\"Hi\" style=\"light\" button-text=\"Join\" button-url=\"https://example.com/join\"}\n:::",
+	":::product{title=\"Widget\" button-text=\"Buy\" button-url=\"https://example.com/buy\"}\nA **great** widget\n:::",
+	":::signup{header=\"Join us\" disclaimer=\"No spam\"}\n:::",
+	"Text after",
+}, "\n\n") + "\n"
+
+// TestConvertToLexical_Shortcodes tests that shortcodes become Lexical cards
+func TestConvertToLexical_Shortcodes(t *testing.T) {
+	lexicalJSON, err := ConvertToLexical(shortcodeSource)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	doc, err := lexical.Parse(lexicalJSON)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	children := doc.Root.Children()
+	var types []string
+	for _, child := range children {
+		types = append(types, child.Type())
+	}
+	want := "toggle,button,embed,bookmark,header,product,signup,paragraph"
+	if got := strings.Join(types, ","); got != want {
+		t.Fatalf("node types = %s; want %s", got, want)
+	}
+
+	toggle := children[0]
+	if toggle.String("heading") != `FAQ "one"` || !strings.Contains(toggle.String("content"), "kg-callout-card-red") {
+		t.Errorf("toggle = %v", toggle)
+	}
+	if embed := children[2]; embed.String("html") != `<iframe src="https://youtube.com/embed/1"></iframe>` {
+		t.Errorf("embed html = %q", embed.String("html"))
+	}
+	if bookmark := children[3]; bookmark.Map("metadata").String("description") != "Publishing" {
+		t.Errorf("bookmark = %v", bookmark)
+	}
+	if header := children[4]; !header.Bool("buttonEnabled") || header.String("size") != "small" {
+		t.Errorf("header = %v", header)
+	}
+	if product := children[5]; !product.Bool("productButtonEnabled") || product.String("productDescription") != "<p>A <strong>great</strong> widget</p>" {
+		t.Errorf("product = %v", product)
+	}
+	if signup := children[6]; signup.String("buttonText") != "Subscribe" {
+		t.Errorf("signup = %v", signup)
+	}
+}
+
+// TestShortcodes_RoundTrip tests that shortcodes survive Markdown → Lexical → Markdown
+func TestShortcodes_RoundTrip(t *testing.T) {
+	lexicalJSON, err := ConvertToLexical(shortcodeSource)
+	if err != nil {
+		t.Fatalf("ConvertToLexical() error: %v", err)
+	}
+	got, err := FromLexicalString(lexicalJSON)
+	if err != nil {
+		t.Fatalf("FromLexicalString() error: %v", err)
+	}
+	if got != shortcodeSource {
+		t.Errorf("round trip mismatch.\nExpected:\n%s\nActual:\n%s", shortcodeSource, got)
+	}
+}
+
+// TestConvertToHTML_Shortcodes tests that shortcodes become Ghost card HTML
+func TestConvertToHTML_Shortcodes(t *testing.T) {
+	got, err := ConvertToHTML(":::callout{emoji=\"💡\" color=\"green\"}\nUse **this**\n:::\n\n:::button{text=\"Go\" url=\"https://example.com\" align=\"left\"}\n:::\n")
+	if err != nil {
+		t.Fatalf("ConvertToHTML() error: %v", err)
+	}
+	want := `<div class="kg-card kg-callout-card kg-callout-card-green"><div class="kg-callout-emoji">💡</div><div class="kg-callout-text"><p>Use <strong>this</strong></p></div></div>` + "\n" +
+		`<div class="kg-card kg-button-card kg-align-left"><a href="https://example.com" class="kg-btn kg-btn-accent">Go</a></div>` + "\n"
+	if got != want {
+		t.Errorf("ConvertToHTML() = %q; want %q", got, want)
+	}
+}
+
+// TestShortcodes_NotShortcodes tests that unknown names and unclosed text stay Markdown
+func TestShortcodes_NotShortcodes(t *testing.T) {
+	got, err := ConvertToHTML(":::unknown{a=1}\ntext\n:::\n")
+	if err != nil {
+		t.Fatalf("ConvertToHTML() error: %v", err)
+	}
+	if got != "<p>:::unknown{a=1}\ntext\n:::</p>\n" {
+		t.Errorf("ConvertToHTML() = %q", got)
+	}
+}
+
+// TestFromHTML_CardShortcodes tests that Ghost card HTML becomes shortcodes
+func TestFromHTML_CardShortcodes(t *testing.T) {
+	input := `<div class="kg-card kg-toggle-card" data-kg-toggle-state="close"><div class="kg-toggle-heading"><h4 class="kg-toggle-heading-text">Question</h4></div><div class="kg-toggle-content"><p>Answer</p></div></div>` +
+		`<div class="kg-card kg-callout-card kg-callout-card-grey"><div class="kg-callout-emoji">🔥</div><div class="kg-callout-text">Hot</div></div>`
+	want := ":::toggle{heading=\"Question\"}\nAnswer\n:::\n\n:::callout{emoji=\"🔥\"}\nHot\n:::\n"
+	if got := FromHTML(input); got != want {
+		t.Errorf("FromHTML() = %q; want %q", got, want)
+	}
+}
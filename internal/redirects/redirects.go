@@ -0,0 +1,200 @@
+/**
+ * redirects.go
+ * Ghost redirects file model
+ *
+ * Parses and writes Ghost's redirects file in both supported formats:
+ *   - JSON: [{"from": "^/old/$", "to": "/new/", "permanent": true}]
+ *   - YAML: {301: {"/old/": "/new/"}, 302: {...}}
+ * Rule order is preserved, since Ghost applies the first matching rule. The
+ * YAML format can only keep it within each status (see Grouped).
+ */
+
+package redirects
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the file format of a redirects file
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// Redirect is a single redirect rule
+type Redirect struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Permanent bool   `json:"permanent,omitempty"`
+}
+
+// DetectFormat detects the format of redirects file content
+func DetectFormat(data []byte) Format {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+// FormatFromFilename returns the format for a file extension (empty if unknown)
+func FormatFromFilename(name string) Format {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".json"):
+		return FormatJSON
+	case strings.HasSuffix(lower, ".yaml"), strings.HasSuffix(lower, ".yml"):
+		return FormatYAML
+	default:
+		return ""
+	}
+}
+
+// Parse parses redirects file content in the given format
+//
+// If format is empty, it is detected from the content.
+func Parse(data []byte, format Format) ([]Redirect, error) {
+	if format == "" {
+		format = DetectFormat(data)
+	}
+
+	switch format {
+	case FormatJSON:
+		var redirects []Redirect
+		if err := json.Unmarshal(data, &redirects); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON redirects: %w", err)
+		}
+		return redirects, nil
+	case FormatYAML:
+		return parseYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported redirects format: %s", format)
+	}
+}
+
+// parseYAML parses the YAML format, keeping rule order
+func parseYAML(data []byte) ([]Redirect, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML redirects: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return []Redirect{}, nil
+	}
+
+	top := doc.Content[0]
+	if top.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("redirects YAML must be a mapping of 301/302 to rules")
+	}
+
+	redirects := []Redirect{}
+	for i := 0; i+1 < len(top.Content); i += 2 {
+		status, rules := top.Content[i], top.Content[i+1]
+
+		var permanent bool
+		switch status.Value {
+		case "301":
+			permanent = true
+		case "302":
+			permanent = false
+		default:
+			return nil, fmt.Errorf("line %d: unknown redirect status %q (use 301 or 302)", status.Line, status.Value)
+		}
+
+		if rules.Kind == yaml.ScalarNode && rules.Tag == "!!null" {
+			continue
+		}
+		if rules.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("line %d: rules under %s must be a mapping of from: to", rules.Line, status.Value)
+		}
+		for j := 0; j+1 < len(rules.Content); j += 2 {
+			redirects = append(redirects, Redirect{
+				From:      rules.Content[j].Value,
+				To:        rules.Content[j+1].Value,
+				Permanent: permanent,
+			})
+		}
+	}
+
+	return redirects, nil
+}
+
+// Marshal writes redirects in the given format
+func Marshal(redirects []Redirect, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if redirects == nil {
+			redirects = []Redirect{}
+		}
+		if err := encoder.Encode(redirects); err != nil {
+			return nil, fmt.Errorf("failed to write JSON redirects: %w", err)
+		}
+		return buf.Bytes(), nil
+	case FormatYAML:
+		return marshalYAML(redirects)
+	default:
+		return nil, fmt.Errorf("unsupported redirects format: %s", format)
+	}
+}
+
+// Grouped returns rules in the order the YAML format keeps them: grouped by
+// status (in the order the statuses first appear), in their original order
+// within each status
+func Grouped(redirects []Redirect) []Redirect {
+	grouped := make([]Redirect, 0, len(redirects))
+	if len(redirects) == 0 {
+		return grouped
+	}
+	for _, permanent := range []bool{redirects[0].Permanent, !redirects[0].Permanent} {
+		for _, r := range redirects {
+			if r.Permanent == permanent {
+				grouped = append(grouped, r)
+			}
+		}
+	}
+	return grouped
+}
+
+// marshalYAML writes the YAML format, grouping rules by status (see Grouped)
+func marshalYAML(redirects []Redirect) ([]byte, error) {
+	top := &yaml.Node{Kind: yaml.MappingNode}
+	var rules *yaml.Node
+	grouped := Grouped(redirects)
+	for i, r := range grouped {
+		// Each status starts a new mapping
+		if i == 0 || r.Permanent != grouped[i-1].Permanent {
+			code := "302"
+			if r.Permanent {
+				code = "301"
+			}
+			rules = &yaml.Node{Kind: yaml.MappingNode}
+			top.Content = append(top.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: code}, rules)
+		}
+		rules.Content = append(rules.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: r.From},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: r.To},
+		)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{top}}); err != nil {
+		return nil, fmt.Errorf("failed to write YAML redirects: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write YAML redirects: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,185 @@
+/**
+ * redirects_test.go
+ * Test code for redirects file parsing and validation
+ */
+
+package redirects
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestParse_YAMLKeepsOrder tests parsing the YAML format in rule order
+func TestParse_YAMLKeepsOrder(t *testing.T) {
+	input := `301:
+  /old/: /new/
+  /a/: /b/
+302:
+  ^/temp/(.*)$: /sale/$1
+`
+	got, err := Parse([]byte(input), "")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	want := []Redirect{
+		{From: "/old/", To: "/new/", Permanent: true},
+		{From: "/a/", To: "/b/", Permanent: true},
+		{From: "^/temp/(.*)$", To: "/sale/$1", Permanent: false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %+v; want %+v", got, want)
+	}
+}
+
+// TestParse_JSON tests parsing the JSON format
+func TestParse_JSON(t *testing.T) {
+	input := `[{"from": "^/old/$", "to": "/new/", "permanent": true}, {"from": "/x/", "to": "/y/"}]`
+	got, err := Parse([]byte(input), "")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if len(got) != 2 || !got[0].Permanent || got[1].Permanent {
+		t.Errorf("Parse() = %+v; want permanent then temporary", got)
+	}
+}
+
+// TestParse_InvalidYAMLStatus tests rejecting unknown status codes
+func TestParse_InvalidYAMLStatus(t *testing.T) {
+	if _, err := Parse([]byte("307:\n  /a/: /b/\n"), FormatYAML); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
+
+// TestMarshal_RoundTrip tests that both formats round-trip
+func TestMarshal_RoundTrip(t *testing.T) {
+	redirects := []Redirect{
+		{From: "/old/", To: "/new/", Permanent: true},
+		{From: "^/temp/$", To: "https://example.com/?a=1&b=2", Permanent: false},
+	}
+
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		data, err := Marshal(redirects, format)
+		if err != nil {
+			t.Fatalf("Marshal(%s) error: %v", format, err)
+		}
+		got, err := Parse(data, format)
+		if err != nil {
+			t.Fatalf("Parse(%s) error: %v\n%s", format, err, data)
+		}
+		if !reflect.DeepEqual(got, redirects) {
+			t.Errorf("%s round trip = %+v; want %+v", format, got, redirects)
+		}
+		if DetectFormat(data) != format {
+			t.Errorf("DetectFormat(%s output) = %s", format, DetectFormat(data))
+		}
+	}
+}
+
+// TestMarshal_YAMLKeepsStatusOrder tests that a YAML file listing 302 rules
+// first is written back unchanged
+func TestMarshal_YAMLKeepsStatusOrder(t *testing.T) {
+	input := `302:
+  /sale/: /offers/
+301:
+  /old/: /new/
+  /a/: /b/
+`
+	rules, err := Parse([]byte(input), FormatYAML)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	got, err := Marshal(rules, FormatYAML)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(got) != input {
+		t.Errorf("Marshal() =\n%s\nwant\n%s", got, input)
+	}
+}
+
+// TestGrouped tests grouping rules by status in their original order
+func TestGrouped(t *testing.T) {
+	rules := []Redirect{
+		{From: "/a/", To: "/1/"},
+		{From: "/b/", To: "/2/", Permanent: true},
+		{From: "/c/", To: "/3/"},
+		{From: "/d/", To: "/4/", Permanent: true},
+	}
+	want := []Redirect{rules[0], rules[2], rules[1], rules[3]}
+	if got := Grouped(rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("Grouped() = %+v; want %+v", got, want)
+	}
+	if got := Grouped(nil); len(got) != 0 {
+		t.Errorf("Grouped(nil) = %+v; want none", got)
+	}
+}
+
+// TestValidate tests detection of each kind of problem
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		redirects []Redirect
+		wantRule  int
+		wantSev   Severity
+		wantMsg   string
+	}{
+		{
+			name:      "invalid regex",
+			redirects: []Redirect{{From: "^/old/(", To: "/new/"}},
+			wantRule:  1, wantSev: SeverityError, wantMsg: "invalid regular expression",
+		},
+		{
+			name:      "duplicate source",
+			redirects: []Redirect{{From: "/a/", To: "/b/"}, {From: "/a/", To: "/c/"}},
+			wantRule:  2, wantSev: SeverityError, wantMsg: "duplicate source",
+		},
+		{
+			name:      "self loop through unanchored match",
+			redirects: []Redirect{{From: "/blog", To: "/blog/posts/"}},
+			wantRule:  1, wantSev: SeverityError, wantMsg: "redirect loop",
+		},
+		{
+			name:      "two rule loop",
+			redirects: []Redirect{{From: "^/a/$", To: "/b/"}, {From: "^/b/$", To: "/a/"}},
+			wantRule:  1, wantSev: SeverityError, wantMsg: "redirect loop (rules 1 -> 2 -> 1)",
+		},
+		{
+			name:      "chain",
+			redirects: []Redirect{{From: "^/a/$", To: "/b/"}, {From: "^/b/$", To: "/c/"}},
+			wantRule:  1, wantSev: SeverityWarning, wantMsg: "point rule 1 directly at /c/",
+		},
+		{
+			name:      "lookahead cannot be checked",
+			redirects: []Redirect{{From: "^/(?!keep)(.*)$", To: "https://example.com/"}},
+			wantRule:  1, wantSev: SeverityWarning, wantMsg: "cannot check",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := Validate(tt.redirects)
+			for _, issue := range issues {
+				if issue.Rule == tt.wantRule && issue.Severity == tt.wantSev && strings.Contains(issue.Message, tt.wantMsg) {
+					return
+				}
+			}
+			t.Errorf("Validate() = %+v; want rule %d %s containing %q", issues, tt.wantRule, tt.wantSev, tt.wantMsg)
+		})
+	}
+}
+
+// TestValidate_CleanRules tests that valid rules produce no issues
+func TestValidate_CleanRules(t *testing.T) {
+	redirects := []Redirect{
+		{From: "^/old/$", To: "/new/", Permanent: true},
+		{From: "/Old-Case/i", To: "/new-case/"},
+		{From: "^/tag/(.*)$", To: "/topics/$1"},
+		{From: "^/ext/$", To: "https://example.com/ext/"},
+	}
+	if issues := Validate(redirects); len(issues) != 0 {
+		t.Errorf("Validate() = %+v; want no issues", issues)
+	}
+}
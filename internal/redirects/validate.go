@@ -0,0 +1,190 @@
+/**
+ * validate.go
+ * Local validation of redirect rules
+ *
+ * Checks the problems Ghost only reports after upload (or not at all):
+ * invalid regular expressions, duplicate sources, redirect loops and chains.
+ * Ghost matches "from" as an unanchored JavaScript regular expression against
+ * the request path; a "/pattern/flags" form sets flags (e.g. /old/i).
+ */
+
+package redirects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Severity of a validation issue
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a problem found in a redirect rule
+type Issue struct {
+	Rule     int      `json:"rule"` // 1-based rule number
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// maxChainSteps bounds loop detection when following redirects
+const maxChainSteps = 20
+
+// flagsPattern matches the "/pattern/flags" form
+var flagsPattern = regexp.MustCompile(`^/(.*)/([a-z]+)$`)
+
+// compiledRule is a rule with its compiled matcher (nil if it could not be compiled)
+type compiledRule struct {
+	index int
+	rule  Redirect
+	re    *regexp.Regexp
+}
+
+// HasErrors reports whether any issue is an error
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks redirect rules and returns the issues found, in rule order
+func Validate(redirects []Redirect) []Issue {
+	var issues []Issue
+	add := func(index int, severity Severity, format string, args ...interface{}) {
+		issues = append(issues, Issue{Rule: index + 1, Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	rules := make([]compiledRule, len(redirects))
+	seen := make(map[string]int)
+	for i, r := range redirects {
+		rules[i] = compiledRule{index: i, rule: r}
+
+		if strings.TrimSpace(r.From) == "" {
+			add(i, SeverityError, "empty \"from\"")
+			continue
+		}
+		if strings.TrimSpace(r.To) == "" {
+			add(i, SeverityError, "empty \"to\"")
+		}
+
+		// Duplicate sources (only the first one ever matches)
+		if first, ok := seen[r.From]; ok {
+			add(i, SeverityError, "duplicate source %q (already used by rule %d)", r.From, first+1)
+		} else {
+			seen[r.From] = i
+		}
+
+		// Regular expression
+		re, err := compileFrom(r.From)
+		if err != nil {
+			if strings.Contains(err.Error(), "invalid or unsupported Perl syntax") {
+				add(i, SeverityWarning, "cannot check %q locally: %v", r.From, err)
+			} else {
+				add(i, SeverityError, "invalid regular expression %q: %v", r.From, err)
+			}
+			continue
+		}
+		rules[i].re = re
+	}
+
+	// Loops and chains
+	for i, r := range redirects {
+		if rules[i].re == nil {
+			continue
+		}
+		start, ok := localPath(r.To)
+		if !ok {
+			continue
+		}
+		if message, severity := followChain(rules, i, start); message != "" {
+			add(i, severity, "%s", message)
+		}
+	}
+
+	return issues
+}
+
+// compileFrom compiles a "from" pattern, honoring the /pattern/flags form
+func compileFrom(from string) (*regexp.Regexp, error) {
+	pattern := from
+	if m := flagsPattern.FindStringSubmatch(from); m != nil {
+		pattern = m[1]
+		if strings.Contains(m[2], "i") {
+			pattern = "(?i)" + pattern
+		}
+	}
+	return regexp.Compile(pattern)
+}
+
+// localPath returns the path of a same-site target, or false for external or templated targets
+func localPath(to string) (string, bool) {
+	if !strings.HasPrefix(to, "/") || strings.HasPrefix(to, "//") || strings.Contains(to, "$") {
+		return "", false
+	}
+	if i := strings.IndexAny(to, "?#"); i >= 0 {
+		to = to[:i]
+	}
+	return to, true
+}
+
+// followChain follows the redirects starting at path for rule start
+//
+// Returns an error message for loops, a warning for chains, or "" otherwise.
+func followChain(rules []compiledRule, start int, path string) (string, Severity) {
+	visited := map[string]bool{path: true}
+	hops := []int{start + 1}
+
+	for step := 0; step < maxChainSteps; step++ {
+		next := matchRule(rules, path)
+		if next == nil {
+			if len(hops) > 1 {
+				return fmt.Sprintf("redirect chain (rules %s); point rule %d directly at %s", joinRules(hops), start+1, path), SeverityWarning
+			}
+			return "", ""
+		}
+
+		// Apply the matched rule
+		match := next.re.FindStringSubmatchIndex(path)
+		target := string(next.re.ExpandString(nil, next.rule.To, path, match))
+		hops = append(hops, next.index+1)
+
+		nextPath, ok := localPath(target)
+		if !ok {
+			// Leaves the site (or cannot be resolved): a chain, but not a loop
+			return fmt.Sprintf("redirect chain (rules %s); point rule %d directly at %s", joinRules(hops), start+1, target), SeverityWarning
+		}
+		if visited[nextPath] {
+			return fmt.Sprintf("redirect loop (rules %s) at %s", joinRules(hops), nextPath), SeverityError
+		}
+		visited[nextPath] = true
+		path = nextPath
+	}
+
+	return fmt.Sprintf("redirect chain longer than %d steps (rules %s)", maxChainSteps, joinRules(hops)), SeverityError
+}
+
+// matchRule returns the first rule whose source matches the path (as Ghost does)
+func matchRule(rules []compiledRule, path string) *compiledRule {
+	for i := range rules {
+		if rules[i].re != nil && rules[i].re.MatchString(path) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// joinRules formats rule numbers as "1 -> 2 -> 3"
+func joinRules(hops []int) string {
+	parts := make([]string, len(hops))
+	for i, hop := range hops {
+		parts[i] = fmt.Sprintf("%d", hop)
+	}
+	return strings.Join(parts, " -> ")
+}
@@ -0,0 +1,464 @@
+/**
+ * routes.go
+ * Ghost routes.yaml validation
+ *
+ * Checks a routes.yaml file against the schema Ghost accepts for its three
+ * sections (routes, collections, taxonomies), and collects the templates it
+ * references so they can be checked against the active theme.
+ */
+
+package routes
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity of a validation issue
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a problem found in routes.yaml
+type Issue struct {
+	Line     int      `json:"line"`
+	Location string   `json:"location"` // e.g. "collections./blog/.permalink"
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// File is a parsed routes.yaml
+type File struct {
+	root *yaml.Node
+}
+
+// Allowed keys per section
+var (
+	routeKeys      = []string{"template", "data", "controller", "filter", "order", "limit", "content_type", "rss"}
+	collectionKeys = []string{"permalink", "template", "data", "filter", "order", "limit", "rss"}
+	taxonomyKeys   = []string{"tag", "author"}
+	sectionKeys    = []string{"routes", "collections", "taxonomies"}
+)
+
+// standardTemplates are theme templates that Ghost does not list as custom templates
+var standardTemplates = []string{"index", "home", "post", "page", "tag", "author"}
+
+// permalinkPlaceholders are the placeholders Ghost supports in permalinks
+var permalinkPlaceholders = []string{"id", "uuid", "slug", "year", "month", "day", "primary_tag", "primary_author"}
+
+// placeholderPattern matches {name} placeholders
+var placeholderPattern = regexp.MustCompile(`\{([^}]*)\}`)
+
+// dataShortPattern matches the short form of data (e.g. page.about, tag.news)
+var dataShortPattern = regexp.MustCompile(`^(post|page|tag|author)\.[a-z0-9-]+$`)
+
+// Parse parses routes.yaml content
+func Parse(data []byte) (*File, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse routes.yaml: %w", err)
+	}
+
+	file := &File{}
+	if len(doc.Content) > 0 {
+		file.root = doc.Content[0]
+	}
+	return file, nil
+}
+
+// Templates returns the template names referenced by routes and collections (sorted, unique)
+func (f *File) Templates() []string {
+	seen := make(map[string]bool)
+	for _, section := range []string{"routes", "collections"} {
+		entries := mappingValue(f.root, section)
+		forEachPair(entries, func(_, value *yaml.Node) {
+			var template *yaml.Node
+			if value.Kind == yaml.ScalarNode && section == "routes" {
+				template = value
+			} else {
+				template = mappingValue(value, "template")
+			}
+			for _, name := range templateNames(template) {
+				seen[name] = true
+			}
+		})
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks the file against Ghost's routes.yaml schema
+//
+// If templates is non-nil, template lists with no name among them are
+// reported as warnings. A list is a set of fallbacks, so one match is
+// enough. Ghost lists only a theme's custom templates, so lists with a
+// standard template (index, post, ...) are not checked.
+func (f *File) Validate(templates []string) []Issue {
+	v := &validator{}
+
+	if f.root == nil || (f.root.Kind == yaml.ScalarNode && f.root.Tag == "!!null") {
+		v.add(1, "", SeverityError, "routes.yaml is empty (it needs routes, collections, and taxonomies)")
+		return v.issues
+	}
+	if f.root.Kind != yaml.MappingNode {
+		v.add(f.root.Line, "", SeverityError, "routes.yaml must be a mapping")
+		return v.issues
+	}
+
+	// Top-level sections
+	forEachPair(f.root, func(key, _ *yaml.Node) {
+		if !contains(sectionKeys, key.Value) {
+			v.add(key.Line, key.Value, SeverityError, "unknown section %q (allowed: %s)", key.Value, strings.Join(sectionKeys, ", "))
+		}
+	})
+	for _, section := range sectionKeys {
+		if mappingKey(f.root, section) == nil {
+			v.add(f.root.Line, section, SeverityWarning, "missing section %q", section)
+		}
+	}
+
+	paths := make(map[string]string)
+	v.validateRoutes(mappingValue(f.root, "routes"), paths)
+	v.validateCollections(mappingValue(f.root, "collections"), paths)
+	v.validateTaxonomies(mappingValue(f.root, "taxonomies"))
+
+	// Custom templates of the active theme
+	if templates != nil {
+		available := make(map[string]bool, len(templates)+len(standardTemplates))
+		for _, name := range standardTemplates {
+			available[name] = true
+		}
+		for _, name := range templates {
+			available[strings.TrimSuffix(name, ".hbs")] = true
+		}
+		for _, section := range []string{"routes", "collections"} {
+			forEachPair(mappingValue(f.root, section), func(key, value *yaml.Node) {
+				template := value
+				if value.Kind != yaml.ScalarNode || section != "routes" {
+					template = mappingValue(value, "template")
+				}
+				names := templateNames(template)
+				for _, name := range names {
+					if available[name] {
+						return
+					}
+				}
+				if len(names) > 0 {
+					v.add(template.Line, section+"."+key.Value+".template", SeverityWarning, "template %s not found among the active theme's custom templates", quoteNames(names))
+				}
+			})
+		}
+	}
+
+	sort.SliceStable(v.issues, func(i, j int) bool { return v.issues[i].Line < v.issues[j].Line })
+	return v.issues
+}
+
+// HasErrors reports whether any issue is an error
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// validator collects issues
+type validator struct {
+	issues []Issue
+}
+
+// add records an issue
+func (v *validator) add(line int, location string, severity Severity, format string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{Line: line, Location: location, Severity: severity, Message: fmt.Sprintf(format, args...)})
+}
+
+// validateRoutes checks the routes section
+func (v *validator) validateRoutes(node *yaml.Node, paths map[string]string) {
+	if !v.checkSection(node, "routes") {
+		return
+	}
+	forEachPair(node, func(key, value *yaml.Node) {
+		location := "routes." + key.Value
+		v.checkPath(key, location, paths)
+
+		// Short form: "/about/: about" renders a template
+		if value.Kind == yaml.ScalarNode {
+			if value.Value == "" {
+				v.add(value.Line, location, SeverityError, "route needs a template")
+			}
+			return
+		}
+		if value.Kind != yaml.MappingNode {
+			v.add(value.Line, location, SeverityError, "route must be a template name or a mapping")
+			return
+		}
+
+		v.checkKeys(value, location, routeKeys)
+		controller := mappingValue(value, "controller")
+		if controller != nil && controller.Value != "channel" {
+			v.add(controller.Line, location+".controller", SeverityError, "unknown controller %q (only \"channel\" is supported)", controller.Value)
+		}
+		if controller == nil && mappingValue(value, "template") == nil && mappingValue(value, "content_type") == nil {
+			v.add(value.Line, location, SeverityError, "route needs a template, controller, or content_type")
+		}
+		if filter := mappingValue(value, "filter"); filter != nil && controller == nil {
+			v.add(filter.Line, location+".filter", SeverityError, "filter is only allowed with controller: channel")
+		}
+		v.checkTemplate(mappingValue(value, "template"), location)
+		v.checkData(mappingValue(value, "data"), location)
+	})
+}
+
+// validateCollections checks the collections section
+func (v *validator) validateCollections(node *yaml.Node, paths map[string]string) {
+	if !v.checkSection(node, "collections") {
+		return
+	}
+	forEachPair(node, func(key, value *yaml.Node) {
+		location := "collections." + key.Value
+		v.checkPath(key, location, paths)
+
+		if value.Kind != yaml.MappingNode {
+			v.add(value.Line, location, SeverityError, "collection must be a mapping with a permalink")
+			return
+		}
+		v.checkKeys(value, location, collectionKeys)
+
+		permalink := mappingValue(value, "permalink")
+		if permalink == nil {
+			v.add(value.Line, location, SeverityError, "collection needs a permalink")
+		} else {
+			v.checkPermalink(permalink, location+".permalink")
+		}
+		v.checkTemplate(mappingValue(value, "template"), location)
+		v.checkData(mappingValue(value, "data"), location)
+	})
+}
+
+// validateTaxonomies checks the taxonomies section
+func (v *validator) validateTaxonomies(node *yaml.Node) {
+	if !v.checkSection(node, "taxonomies") {
+		return
+	}
+	forEachPair(node, func(key, value *yaml.Node) {
+		location := "taxonomies." + key.Value
+		if !contains(taxonomyKeys, key.Value) {
+			v.add(key.Line, location, SeverityError, "unknown taxonomy %q (allowed: %s)", key.Value, strings.Join(taxonomyKeys, ", "))
+			return
+		}
+		if value.Kind != yaml.ScalarNode {
+			v.add(value.Line, location, SeverityError, "taxonomy must be a permalink string")
+			return
+		}
+		if !strings.Contains(value.Value, "{slug}") {
+			v.add(value.Line, location, SeverityError, "taxonomy permalink must contain {slug}")
+		}
+		v.checkSlashes(value, location)
+	})
+}
+
+// checkSection checks that a section is a mapping (null sections are allowed)
+func (v *validator) checkSection(node *yaml.Node, name string) bool {
+	if node == nil || (node.Kind == yaml.ScalarNode && node.Tag == "!!null") {
+		return false
+	}
+	if node.Kind != yaml.MappingNode {
+		v.add(node.Line, name, SeverityError, "section %q must be a mapping", name)
+		return false
+	}
+	return true
+}
+
+// checkPath checks a route or collection path and that it is unique
+func (v *validator) checkPath(key *yaml.Node, location string, paths map[string]string) {
+	v.checkSlashes(key, location)
+	if placeholderPattern.MatchString(key.Value) && strings.HasPrefix(location, "collections.") {
+		v.add(key.Line, location, SeverityError, "collection path must not contain placeholders")
+	}
+	if other, ok := paths[key.Value]; ok {
+		v.add(key.Line, location, SeverityError, "path %s is already used by %s", key.Value, other)
+		return
+	}
+	paths[key.Value] = location
+}
+
+// checkSlashes checks that a path starts and ends with a slash
+func (v *validator) checkSlashes(node *yaml.Node, location string) {
+	if !strings.HasPrefix(node.Value, "/") {
+		v.add(node.Line, location, SeverityError, "%q must start with a slash", node.Value)
+	}
+	if !strings.HasSuffix(node.Value, "/") {
+		v.add(node.Line, location, SeverityError, "%q must end with a slash", node.Value)
+	}
+}
+
+// checkPermalink checks a collection permalink
+func (v *validator) checkPermalink(node *yaml.Node, location string) {
+	if node.Kind != yaml.ScalarNode {
+		v.add(node.Line, location, SeverityError, "permalink must be a string")
+		return
+	}
+	v.checkSlashes(node, location)
+
+	matches := placeholderPattern.FindAllStringSubmatch(node.Value, -1)
+	if len(matches) == 0 {
+		v.add(node.Line, location, SeverityError, "permalink must contain a placeholder such as {slug} or {id}")
+	}
+	for _, m := range matches {
+		if !contains(permalinkPlaceholders, m[1]) {
+			v.add(node.Line, location, SeverityError, "unknown permalink placeholder {%s} (allowed: %s)", m[1], strings.Join(permalinkPlaceholders, ", "))
+		}
+	}
+}
+
+// checkTemplate checks a template value (a name or a list of names)
+func (v *validator) checkTemplate(node *yaml.Node, location string) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if strings.HasSuffix(node.Value, ".hbs") {
+			v.add(node.Line, location+".template", SeverityError, "template %q must not include the .hbs extension", node.Value)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				v.add(item.Line, location+".template", SeverityError, "template list must contain names")
+			} else if strings.HasSuffix(item.Value, ".hbs") {
+				v.add(item.Line, location+".template", SeverityError, "template %q must not include the .hbs extension", item.Value)
+			}
+		}
+	default:
+		v.add(node.Line, location+".template", SeverityError, "template must be a name or a list of names")
+	}
+}
+
+// checkData checks a data value (short form "page.about" or a mapping of names to queries)
+func (v *validator) checkData(node *yaml.Node, location string) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if !dataShortPattern.MatchString(node.Value) {
+			v.add(node.Line, location+".data", SeverityError, "data %q must look like post.slug, page.slug, tag.slug, or author.slug", node.Value)
+		}
+	case yaml.MappingNode:
+		forEachPair(node, func(key, value *yaml.Node) {
+			if value.Kind == yaml.ScalarNode {
+				if !dataShortPattern.MatchString(value.Value) {
+					v.add(value.Line, location+".data."+key.Value, SeverityError, "data %q must look like post.slug, page.slug, tag.slug, or author.slug", value.Value)
+				}
+				return
+			}
+			if value.Kind != yaml.MappingNode || mappingValue(value, "resource") == nil {
+				v.add(value.Line, location+".data."+key.Value, SeverityError, "data query needs a resource")
+			}
+		})
+	default:
+		v.add(node.Line, location+".data", SeverityError, "data must be a string or a mapping")
+	}
+}
+
+// checkKeys reports keys that are not allowed
+func (v *validator) checkKeys(node *yaml.Node, location string, allowed []string) {
+	forEachPair(node, func(key, _ *yaml.Node) {
+		if !contains(allowed, key.Value) {
+			v.add(key.Line, location+"."+key.Value, SeverityError, "unknown key %q (allowed: %s)", key.Value, strings.Join(allowed, ", "))
+		}
+	})
+}
+
+// quoteNames quotes template names and joins them with " or "
+func quoteNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	return strings.Join(quoted, " or ")
+}
+
+// templateNames returns the template names in a template node
+func templateNames(node *yaml.Node) []string {
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Value == "" || node.Tag == "!!null" {
+			return nil
+		}
+		return []string{node.Value}
+	case yaml.SequenceNode:
+		var names []string
+		for _, item := range node.Content {
+			if item.Kind == yaml.ScalarNode && item.Value != "" {
+				names = append(names, item.Value)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// mappingKey returns the key node for a key in a mapping
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+// mappingValue returns the value node for a key in a mapping
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// forEachPair calls fn for each key/value pair of a mapping
+func forEachPair(node *yaml.Node, fn func(key, value *yaml.Node)) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i], node.Content[i+1])
+	}
+}
+
+// contains reports whether a list contains a value
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
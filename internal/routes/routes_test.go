@@ -0,0 +1,139 @@
+/**
+ * routes_test.go
+ * Test code for routes.yaml validation
+ */
+
+package routes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// defaultRoutes is Ghost's default routes.yaml
+const defaultRoutes = `routes:
+
+collections:
+  /:
+    permalink: /{slug}/
+    template: index
+
+taxonomies:
+  tag: /tag/{slug}/
+  author: /author/{slug}/
+`
+
+// TestValidate_DefaultRoutes tests that Ghost's default file is valid
+func TestValidate_DefaultRoutes(t *testing.T) {
+	file, err := Parse([]byte(defaultRoutes))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if issues := file.Validate([]string{"index.hbs", "post.hbs"}); len(issues) != 0 {
+		t.Errorf("Validate() = %+v; want no issues", issues)
+	}
+}
+
+// TestTemplates_CollectsReferencedTemplates tests collecting templates from routes and collections
+func TestTemplates_CollectsReferencedTemplates(t *testing.T) {
+	input := `routes:
+  /about/: about
+  /features/:
+    template: features
+    data: page.features
+collections:
+  /blog/:
+    permalink: /blog/{slug}/
+    template: [blog, index]
+taxonomies:
+  tag: /tag/{slug}/
+`
+	file, err := Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	want := []string{"about", "blog", "features", "index"}
+	if got := file.Templates(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Templates() = %v; want %v", got, want)
+	}
+}
+
+// TestValidate_SchemaErrors tests detection of schema problems
+func TestValidate_SchemaErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantMsg string
+	}{
+		{"unknown section", "routes:\ncollections:\ntaxonomies:\nredirects:\n", `unknown section "redirects"`},
+		{"missing trailing slash", "routes:\n  /about: about\n", "must end with a slash"},
+		{"permalink without placeholder", "collections:\n  /:\n    permalink: /posts/\n", "must contain a placeholder"},
+		{"unknown placeholder", "collections:\n  /:\n    permalink: /{title}/\n", "unknown permalink placeholder {title}"},
+		{"missing permalink", "collections:\n  /:\n    template: index\n", "needs a permalink"},
+		{"unknown taxonomy", "taxonomies:\n  category: /category/{slug}/\n", `unknown taxonomy "category"`},
+		{"bad controller", "routes:\n  /news/:\n    controller: list\n", `unknown controller "list"`},
+		{"filter without channel", "routes:\n  /news/:\n    template: news\n    filter: tag:news\n", "only allowed with controller: channel"},
+		{"hbs extension", "routes:\n  /about/:\n    template: about.hbs\n", "must not include the .hbs extension"},
+		{"bad data", "routes:\n  /about/:\n    template: about\n    data: about\n", "must look like"},
+		{"duplicate path", "routes:\n  /blog/: blog\ncollections:\n  /blog/:\n    permalink: /blog/{slug}/\n", "already used by routes./blog/"},
+		{"unknown route key", "routes:\n  /about/:\n    template: about\n    layout: wide\n", `unknown key "layout"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			issues := file.Validate(nil)
+			for _, issue := range issues {
+				if issue.Severity == SeverityError && strings.Contains(issue.Message, tt.wantMsg) {
+					return
+				}
+			}
+			t.Errorf("Validate() = %+v; want error containing %q", issues, tt.wantMsg)
+		})
+	}
+}
+
+// TestValidate_MissingTemplate tests checking templates against the theme
+func TestValidate_MissingTemplate(t *testing.T) {
+	input := defaultRoutes + "\n"
+	input = strings.Replace(input, "routes:\n", "routes:\n  /features/: features\n", 1)
+	file, err := Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	issues := file.Validate([]string{"custom-about.hbs"})
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, `template "features" not found`) || issues[0].Line != 2 {
+		t.Errorf("Validate() = %+v; want missing template features on line 2", issues)
+	}
+	if HasErrors(issues) {
+		t.Errorf("Validate() = %+v; want a warning (standard templates are not listed by Ghost)", issues)
+	}
+}
+
+// TestValidate_TemplateFallbacks tests that one template in a fallback list is enough
+func TestValidate_TemplateFallbacks(t *testing.T) {
+	input := defaultRoutes + "\n"
+	input = strings.Replace(input, "routes:\n", "routes:\n  /features/:\n    template: [features, index]\n  /about/:\n    template: [about, custom-about]\n  /team/:\n    template: [team, staff]\n", 1)
+	file, err := Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	issues := file.Validate([]string{"custom-about.hbs"})
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, `template "team" or "staff" not found`) {
+		t.Errorf("Validate() = %+v; want only the team list reported", issues)
+	}
+}
+
+// TestParse_InvalidYAML tests YAML syntax errors
+func TestParse_InvalidYAML(t *testing.T) {
+	if _, err := Parse([]byte("routes:\n  /a/: [\n")); err == nil {
+		t.Error("expected error for invalid YAML")
+	}
+}
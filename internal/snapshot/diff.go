@@ -0,0 +1,150 @@
+/**
+ * diff.go
+ * Snapshot comparison
+ *
+ * Compares two snapshot manifests object by object, and reports which
+ * top-level fields changed in modified objects.
+ */
+
+package snapshot
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// ChangeKind is the kind of change to an object
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// Change is a difference between two snapshots
+type Change struct {
+	Collection string     `json:"collection"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name,omitempty"`
+	Kind       ChangeKind `json:"kind"`
+	Fields     []string   `json:"fields,omitempty"`
+}
+
+// Diff returns the changes from snapshot a to snapshot b
+//
+// Changes are sorted by collection, kind, and name. Field names of modified
+// objects are filled in when both objects can be read from the store.
+func (s *Store) Diff(a, b *Manifest) []Change {
+	changes := []Change{}
+
+	collections := map[string]bool{}
+	for name := range a.Collections {
+		collections[name] = true
+	}
+	for name := range b.Collections {
+		collections[name] = true
+	}
+
+	for collection := range collections {
+		before := a.Collections[collection]
+		after := b.Collections[collection]
+
+		for id, entry := range after {
+			old, ok := before[id]
+			switch {
+			case !ok:
+				changes = append(changes, Change{Collection: collection, ID: id, Name: entry.Name, Kind: ChangeAdded})
+			case old.Hash != entry.Hash:
+				changes = append(changes, Change{
+					Collection: collection,
+					ID:         id,
+					Name:       entry.Name,
+					Kind:       ChangeModified,
+					Fields:     s.changedFields(old.Hash, entry.Hash),
+				})
+			}
+		}
+		for id, entry := range before {
+			if _, ok := after[id]; !ok {
+				changes = append(changes, Change{Collection: collection, ID: id, Name: entry.Name, Kind: ChangeRemoved})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		ci, cj := changes[i], changes[j]
+		if ci.Collection != cj.Collection {
+			return ci.Collection < cj.Collection
+		}
+		if ci.Kind != cj.Kind {
+			return ci.Kind < cj.Kind
+		}
+		if ci.Name != cj.Name {
+			return ci.Name < cj.Name
+		}
+		return ci.ID < cj.ID
+	})
+	return changes
+}
+
+// changedFields returns the top-level fields that differ between two objects
+//
+// updated_at is left out since it changes with every edit. Settings objects
+// (key/value lists) report the changed setting keys instead.
+func (s *Store) changedFields(hashA, hashB string) []string {
+	dataA, errA := s.GetObject(hashA)
+	dataB, errB := s.GetObject(hashB)
+	if errA != nil || errB != nil {
+		return nil
+	}
+
+	objA, objB := flatten(dataA), flatten(dataB)
+	if objA == nil || objB == nil {
+		return nil
+	}
+
+	keys := map[string]bool{}
+	for key := range objA {
+		keys[key] = true
+	}
+	for key := range objB {
+		keys[key] = true
+	}
+
+	fields := []string{}
+	for key := range keys {
+		if key == "updated_at" {
+			continue
+		}
+		if !reflect.DeepEqual(objA[key], objB[key]) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// flatten decodes an object into a map of its fields
+//
+// A list of {"key": ..., "value": ...} items (settings) is keyed by "key".
+func flatten(data []byte) map[string]interface{} {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err == nil {
+		return obj
+	}
+
+	var items []struct {
+		Key   string      `json:"key"`
+		Value interface{} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil
+	}
+	obj = map[string]interface{}{}
+	for _, item := range items {
+		obj[item.Key] = item.Value
+	}
+	return obj
+}
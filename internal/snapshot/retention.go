@@ -0,0 +1,72 @@
+/**
+ * retention.go
+ * Snapshot retention policy
+ *
+ * Keeps the newest snapshot of each of the last N days and the last M
+ * ISO weeks that have snapshots.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Policy is a retention policy (zero values keep nothing for that rule)
+type Policy struct {
+	Daily  int // Number of days to keep one snapshot for
+	Weekly int // Number of weeks to keep one snapshot for
+}
+
+// IsZero reports whether the policy keeps everything
+func (p Policy) IsZero() bool {
+	return p.Daily <= 0 && p.Weekly <= 0
+}
+
+// Retain returns the IDs of snapshots to keep under the policy
+//
+// Days and weeks are evaluated in loc. The newest snapshot is always kept,
+// and a zero policy keeps every snapshot.
+func Retain(manifests []*Manifest, policy Policy, loc *time.Location) map[string]bool {
+	keep := map[string]bool{}
+	if len(manifests) == 0 {
+		return keep
+	}
+
+	// Newest first
+	sorted := append([]*Manifest{}, manifests...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	if policy.IsZero() {
+		for _, m := range sorted {
+			keep[m.ID] = true
+		}
+		return keep
+	}
+	keep[sorted[0].ID] = true
+
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+	for _, m := range sorted {
+		t := m.CreatedAt.In(loc)
+
+		day := t.Format("2006-01-02")
+		if !days[day] && len(days) < policy.Daily {
+			days[day] = true
+			keep[m.ID] = true
+		}
+
+		year, week := t.ISOWeek()
+		weekKey := fmt.Sprintf("%d-W%02d", year, week)
+		if !weeks[weekKey] && len(weeks) < policy.Weekly {
+			weeks[weekKey] = true
+			keep[m.ID] = true
+		}
+	}
+
+	return keep
+}
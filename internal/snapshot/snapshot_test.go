@@ -0,0 +1,144 @@
+/**
+ * snapshot_test.go
+ * Test code for the snapshot store, retention, and diff
+ */
+
+package snapshot
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestPutObject_DeduplicatesByContent tests content-addressed storage
+func TestPutObject_DeduplicatesByContent(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	hashA, err := store.PutObject(map[string]string{"title": "Hello"})
+	if err != nil {
+		t.Fatalf("PutObject() error: %v", err)
+	}
+	hashB, _ := store.PutObject(map[string]string{"title": "Hello"})
+	hashC, _ := store.PutObject(map[string]string{"title": "World"})
+
+	if hashA != hashB {
+		t.Errorf("same content stored under %s and %s", hashA, hashB)
+	}
+	if hashA == hashC {
+		t.Error("different content stored under the same hash")
+	}
+	if !store.HasObject(hashA) {
+		t.Error("HasObject() = false after PutObject")
+	}
+}
+
+// TestLoadManifest_LatestAndPrefix tests resolving snapshot IDs
+func TestLoadManifest_LatestAndPrefix(t *testing.T) {
+	store := NewStore(t.TempDir())
+	for _, ts := range []string{"2024-01-01T10:00:00Z", "2024-01-02T10:00:00Z"} {
+		now, _ := time.Parse(time.RFC3339, ts)
+		if err := store.SaveManifest(NewManifest(now, "")); err != nil {
+			t.Fatalf("SaveManifest() error: %v", err)
+		}
+	}
+
+	latest, err := store.LoadManifest("latest")
+	if err != nil || latest.ID != "20240102T100000Z" {
+		t.Errorf("LoadManifest(latest) = %v, %v", latest, err)
+	}
+	first, err := store.LoadManifest("20240101")
+	if err != nil || first.ID != "20240101T100000Z" {
+		t.Errorf("LoadManifest(prefix) = %v, %v", first, err)
+	}
+	if _, err := store.LoadManifest("2024"); err == nil {
+		t.Error("expected error for ambiguous prefix")
+	}
+}
+
+// TestRetain_DailyAndWeekly tests the retention policy
+func TestRetain_DailyAndWeekly(t *testing.T) {
+	// Two snapshots a day for 15 days
+	start := time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC) // Monday
+	manifests := []*Manifest{}
+	for day := 0; day < 15; day++ {
+		for _, hour := range []int{0, 12} {
+			manifests = append(manifests, NewManifest(start.AddDate(0, 0, day).Add(time.Duration(hour)*time.Hour), ""))
+		}
+	}
+
+	keep := Retain(manifests, Policy{Daily: 2, Weekly: 2}, time.UTC)
+
+	want := map[string]bool{
+		"20240115T180000Z": true, // newest (day 15, week 3)
+		"20240114T180000Z": true, // day 14 (week 2)
+	}
+	if !reflect.DeepEqual(keep, want) {
+		t.Errorf("Retain() = %v; want %v", keep, want)
+	}
+
+	// Three weeks keep the newest of week 1 as well
+	keep = Retain(manifests, Policy{Weekly: 3}, time.UTC)
+	if !keep["20240107T180000Z"] || len(keep) != 3 {
+		t.Errorf("Retain(weekly 3) = %v", keep)
+	}
+
+	// A zero policy keeps everything
+	if keep := Retain(manifests, Policy{}, time.UTC); len(keep) != len(manifests) {
+		t.Errorf("Retain(zero) kept %d of %d", len(keep), len(manifests))
+	}
+}
+
+// TestDiff_ReportsChangesAndFields tests comparing snapshots
+func TestDiff_ReportsChangesAndFields(t *testing.T) {
+	store := NewStore(t.TempDir())
+	put := func(v interface{}) string {
+		hash, err := store.PutObject(v)
+		if err != nil {
+			t.Fatalf("PutObject() error: %v", err)
+		}
+		return hash
+	}
+
+	a := NewManifest(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), "")
+	a.Add("posts", "1", Entry{Hash: put(map[string]string{"title": "One", "updated_at": "a"}), Name: "One"})
+	a.Add("posts", "2", Entry{Hash: put(map[string]string{"title": "Two"}), Name: "Two"})
+	a.Add("settings", "settings", Entry{Hash: put([]map[string]string{{"key": "title", "value": "Blog"}}), Name: "settings"})
+
+	b := NewManifest(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), "")
+	b.Add("posts", "1", Entry{Hash: put(map[string]string{"title": "One!", "updated_at": "b"}), Name: "One!"})
+	b.Add("tags", "t", Entry{Hash: put(map[string]string{"name": "News"}), Name: "News"})
+	b.Add("settings", "settings", Entry{Hash: put([]map[string]string{{"key": "title", "value": "My Blog"}}), Name: "settings"})
+
+	got := store.Diff(a, b)
+	want := []Change{
+		{Collection: "posts", ID: "1", Name: "One!", Kind: ChangeModified, Fields: []string{"title"}},
+		{Collection: "posts", ID: "2", Name: "Two", Kind: ChangeRemoved},
+		{Collection: "settings", ID: "settings", Name: "settings", Kind: ChangeModified, Fields: []string{"title"}},
+		{Collection: "tags", ID: "t", Name: "News", Kind: ChangeAdded},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %+v; want %+v", got, want)
+	}
+}
+
+// TestGC_RemovesUnreferencedObjects tests garbage collection after pruning
+func TestGC_RemovesUnreferencedObjects(t *testing.T) {
+	store := NewStore(t.TempDir())
+	kept, _ := store.PutObject("kept")
+	dropped, _ := store.PutObject("dropped")
+
+	m := NewManifest(time.Now(), "")
+	m.Add("posts", "1", Entry{Hash: kept})
+	if err := store.SaveManifest(m); err != nil {
+		t.Fatalf("SaveManifest() error: %v", err)
+	}
+
+	removed, err := store.GC()
+	if err != nil || removed != 1 {
+		t.Errorf("GC() = %d, %v; want 1", removed, err)
+	}
+	if !store.HasObject(kept) || store.HasObject(dropped) {
+		t.Error("GC() removed the wrong objects")
+	}
+}
@@ -0,0 +1,308 @@
+/**
+ * store.go
+ * Content-addressed snapshot store
+ *
+ * Objects are stored once under the SHA-256 of their JSON, and each snapshot
+ * is a manifest that maps collection/ID to an object hash. Unchanged objects
+ * are shared between snapshots.
+ *
+ * Layout:
+ *   <dir>/objects/ab/abcdef....json
+ *   <dir>/manifests/<snapshot-id>.json
+ */
+
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// IDFormat is the time layout of snapshot IDs (UTC)
+const IDFormat = "20060102T150405Z"
+
+// Entry is one object recorded in a snapshot
+type Entry struct {
+	Hash      string `json:"hash"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Manifest describes one snapshot
+type Manifest struct {
+	ID          string                      `json:"id"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	Site        string                      `json:"site,omitempty"`
+	Collections map[string]map[string]Entry `json:"collections"`
+}
+
+// NewManifest creates an empty manifest for the given time
+func NewManifest(now time.Time, site string) *Manifest {
+	now = now.UTC().Truncate(time.Second)
+	return &Manifest{
+		ID:          now.Format(IDFormat),
+		CreatedAt:   now,
+		Site:        site,
+		Collections: map[string]map[string]Entry{},
+	}
+}
+
+// Add records an object in a collection
+func (m *Manifest) Add(collection, id string, entry Entry) {
+	if m.Collections[collection] == nil {
+		m.Collections[collection] = map[string]Entry{}
+	}
+	m.Collections[collection][id] = entry
+}
+
+// Lookup returns the entry of an object, if present
+func (m *Manifest) Lookup(collection, id string) (Entry, bool) {
+	if m == nil {
+		return Entry{}, false
+	}
+	entry, ok := m.Collections[collection][id]
+	return entry, ok
+}
+
+// Store is a snapshot directory
+type Store struct {
+	Dir string
+}
+
+// NewStore returns a store rooted at dir
+func NewStore(dir string) *Store {
+	return &Store{Dir: dir}
+}
+
+// objectPath returns the file path of an object
+func (s *Store) objectPath(hash string) string {
+	return filepath.Join(s.Dir, "objects", hash[:2], hash+".json")
+}
+
+// manifestPath returns the file path of a manifest
+func (s *Store) manifestPath(id string) string {
+	return filepath.Join(s.Dir, "manifests", id+".json")
+}
+
+// PutObject stores a value and returns its hash
+//
+// Storing an existing object is a no-op.
+func (s *Store) PutObject(v interface{}) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode object: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	path := s.objectPath(hash)
+	if _, err := os.Stat(path); err == nil {
+		return hash, nil
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// HasObject reports whether an object exists
+func (s *Store) HasObject(hash string) bool {
+	if len(hash) < 2 {
+		return false
+	}
+	_, err := os.Stat(s.objectPath(hash))
+	return err == nil
+}
+
+// GetObject reads the JSON of an object
+func (s *Store) GetObject(hash string) ([]byte, error) {
+	if len(hash) < 2 {
+		return nil, fmt.Errorf("invalid object hash: %q", hash)
+	}
+	data, err := os.ReadFile(s.objectPath(hash))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return data, nil
+}
+
+// SaveManifest writes a manifest
+func (s *Store) SaveManifest(m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	return writeFileAtomic(s.manifestPath(m.ID), data)
+}
+
+// LoadManifest reads a manifest by ID
+//
+// "latest" selects the newest snapshot, and a unique ID prefix is accepted.
+func (s *Store) LoadManifest(id string) (*Manifest, error) {
+	ids, err := s.IDs()
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := ""
+	switch {
+	case id == "latest":
+		if len(ids) > 0 {
+			resolved = ids[len(ids)-1]
+		}
+	default:
+		for _, candidate := range ids {
+			if candidate == id {
+				resolved = candidate
+				break
+			}
+			if strings.HasPrefix(candidate, id) {
+				if resolved != "" {
+					return nil, fmt.Errorf("ambiguous snapshot ID: %s", id)
+				}
+				resolved = candidate
+			}
+		}
+	}
+	if resolved == "" {
+		return nil, fmt.Errorf("snapshot not found: %s", id)
+	}
+
+	data, err := os.ReadFile(s.manifestPath(resolved))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", resolved, err)
+	}
+	return &m, nil
+}
+
+// Latest returns the newest snapshot, or nil if there is none
+func (s *Store) Latest() (*Manifest, error) {
+	ids, err := s.IDs()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	return s.LoadManifest(ids[len(ids)-1])
+}
+
+// IDs returns all snapshot IDs, oldest first
+func (s *Store) IDs() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(s.Dir, "manifests"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshots: %w", err)
+	}
+
+	ids := []string{}
+	for _, entry := range entries {
+		if id, ok := strings.CutSuffix(entry.Name(), ".json"); ok && !entry.IsDir() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// List returns all manifests, oldest first
+func (s *Store) List() ([]*Manifest, error) {
+	ids, err := s.IDs()
+	if err != nil {
+		return nil, err
+	}
+	manifests := make([]*Manifest, 0, len(ids))
+	for _, id := range ids {
+		m, err := s.LoadManifest(id)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
+
+// DeleteManifest removes a snapshot (objects are removed by GC)
+func (s *Store) DeleteManifest(id string) error {
+	if err := os.Remove(s.manifestPath(id)); err != nil {
+		return fmt.Errorf("failed to delete snapshot %s: %w", id, err)
+	}
+	return nil
+}
+
+// GC removes objects no longer referenced by any snapshot
+//
+// Returns the number of removed objects.
+func (s *Store) GC() (int, error) {
+	manifests, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+	referenced := map[string]bool{}
+	for _, m := range manifests {
+		for _, entries := range m.Collections {
+			for _, entry := range entries {
+				referenced[entry.Hash] = true
+			}
+		}
+	}
+
+	removed := 0
+	root := filepath.Join(s.Dir, "objects")
+	err = filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil || d.IsDir() {
+			return err
+		}
+		hash, ok := strings.CutSuffix(d.Name(), ".json")
+		if !ok || referenced[hash] {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	if err != nil {
+		return removed, fmt.Errorf("failed to remove objects: %w", err)
+	}
+	return removed, nil
+}
+
+// writeFileAtomic writes a file through a temporary file in the same directory
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
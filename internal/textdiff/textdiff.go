@@ -0,0 +1,309 @@
+/**
+ * textdiff.go
+ * Line-based unified diffs
+ *
+ * Computes a minimal line diff with Myers' O(ND) algorithm in linear space
+ * (bisecting at the middle snake, after trimming the common prefix and
+ * suffix; very large rewrites are replaced as a whole) and formats it as a
+ * unified diff with context lines, or merges two versions with git-style
+ * conflict markers.
+ */
+
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OpKind is the kind of a diff line
+type OpKind byte
+
+const (
+	// Equal is a line present on both sides
+	Equal OpKind = ' '
+	// Delete is a line only in the old text
+	Delete OpKind = '-'
+	// Insert is a line only in the new text
+	Insert OpKind = '+'
+)
+
+// Op is one line of a diff
+type Op struct {
+	Kind OpKind
+	Text string
+}
+
+// Lines returns the line diff of a and b
+func Lines(a, b string) []Op {
+	return diff(nil, splitLines(a), splitLines(b))
+}
+
+// diff appends the line diff of x and y to ops
+func diff(ops []Op, x, y []string) []Op {
+	// Common prefix and suffix
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	for _, line := range x[:prefix] {
+		ops = append(ops, Op{Equal, line})
+	}
+	mx, my := x[prefix:len(x)-suffix], y[prefix:len(y)-suffix]
+	if i, j, ok := bisect(mx, my); ok {
+		ops = diff(ops, mx[:i], my[:j])
+		ops = diff(ops, mx[i:], my[j:])
+	} else {
+		for _, line := range mx {
+			ops = append(ops, Op{Delete, line})
+		}
+		for _, line := range my {
+			ops = append(ops, Op{Insert, line})
+		}
+	}
+	for _, line := range x[len(x)-suffix:] {
+		ops = append(ops, Op{Equal, line})
+	}
+	return ops
+}
+
+// maxCost bounds the search for the middle snake: regions that need more
+// edits than this on each side are replaced as a whole, keeping huge
+// rewrites fast (the diff is still correct, but no longer minimal)
+const maxCost = 2048
+
+// bisect finds the middle snake of an optimal path from both ends at once
+// (Myers, "An O(ND) Difference Algorithm and Its Variations", section 4b)
+// and returns the point to split x and y at; ok is false if the texts have
+// no line in common or the search exceeds maxCost
+func bisect(x, y []string) (i, j int, ok bool) {
+	n, m := len(x), len(y)
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+
+	// forward[offset+k] and backward[offset+k] are the furthest x reached on
+	// diagonal k (x-y) from the start and, on the reversed texts, from the end
+	maxD := min((n+m+1)/2, maxCost)
+	offset := maxD
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for k := range forward {
+		forward[k], backward[k] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	// The paths meet on a forward step if the length difference is odd
+	delta := n - m
+	odd := delta%2 != 0
+
+	// Diagonals that ran off the grid are no longer extended
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var px int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				px = forward[offset+k+1]
+			} else {
+				px = forward[offset+k-1] + 1
+			}
+			py := px - k
+			for px < n && py < m && x[px] == y[py] {
+				px++
+				py++
+			}
+			forward[offset+k] = px
+
+			switch {
+			case px > n:
+				fEnd += 2
+			case py > m:
+				fStart += 2
+			case odd:
+				if bk := offset + delta - k; bk >= 0 && bk < len(backward) && backward[bk] != -1 && px >= n-backward[bk] {
+					return px, py, true
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var px int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				px = backward[offset+k+1]
+			} else {
+				px = backward[offset+k-1] + 1
+			}
+			py := px - k
+			for px < n && py < m && x[n-1-px] == y[m-1-py] {
+				px++
+				py++
+			}
+			backward[offset+k] = px
+
+			switch {
+			case px > n:
+				bEnd += 2
+			case py > m:
+				bStart += 2
+			case !odd:
+				if fk := offset + delta - k; fk >= 0 && fk < len(forward) && forward[fk] != -1 && forward[fk] >= n-px {
+					fx := forward[fk]
+					return fx, fx - (delta - k), true
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// splitLines splits text into lines (a trailing newline does not add a line)
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Unified returns the unified diff of a and b with the given number of
+// context lines ("" if the texts have the same lines)
+func Unified(fromName, toName, a, b string, context int) string {
+	hunks := Hunks(a, b, context)
+	if hunks == "" {
+		return ""
+	}
+	return fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName) + hunks
+}
+
+// Hunks returns the hunks of the unified diff of a and b (without the file header)
+func Hunks(a, b string, context int) string {
+	ops := Lines(a, b)
+
+	var out strings.Builder
+	for start := 0; start < len(ops); {
+		// Find the next change
+		for start < len(ops) && ops[start].Kind == Equal {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+
+		// Extend the hunk while changes are within 2*context lines of each other
+		end := start
+		for end < len(ops) {
+			if ops[end].Kind != Equal {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].Kind == Equal {
+				run++
+			}
+			if run == len(ops) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+
+		from := max(start-context, 0)
+		to := min(end+context, len(ops))
+		writeHunk(&out, ops, from, to)
+		start = to
+	}
+	return out.String()
+}
+
+// writeHunk writes ops[from:to] as a hunk with its line range header
+func writeHunk(out *strings.Builder, ops []Op, from, to int) {
+	// Line numbers (1-based) of the hunk start on each side
+	aLine, bLine := 1, 1
+	for _, op := range ops[:from] {
+		if op.Kind != Insert {
+			aLine++
+		}
+		if op.Kind != Delete {
+			bLine++
+		}
+	}
+	aCount, bCount := 0, 0
+	for _, op := range ops[from:to] {
+		if op.Kind != Insert {
+			aCount++
+		}
+		if op.Kind != Delete {
+			bCount++
+		}
+	}
+	// An empty range starts at the line before (as in diff -u)
+	if aCount == 0 {
+		aLine--
+	}
+	if bCount == 0 {
+		bLine--
+	}
+
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(aLine, aCount), hunkRange(bLine, bCount))
+	for _, op := range ops[from:to] {
+		out.WriteByte(byte(op.Kind))
+		out.WriteString(op.Text)
+		out.WriteByte('\n')
+	}
+}
+
+// hunkRange formats a hunk line range ("3" for one line, "3,4" otherwise)
+func hunkRange(line, count int) string {
+	if count == 1 {
+		return fmt.Sprint(line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// ConflictStart, ConflictSeparator, and ConflictEnd begin conflict marker lines
+const (
+	ConflictStart     = "<<<<<<<"
+	ConflictSeparator = "======="
+	ConflictEnd       = ">>>>>>>"
+)
+
+// Conflicts returns a and b merged with git-style conflict markers around
+// every region where they differ
+func Conflicts(a, b, aLabel, bLabel string) string {
+	var out, aLines, bLines strings.Builder
+	flush := func() {
+		if aLines.Len() == 0 && bLines.Len() == 0 {
+			return
+		}
+		fmt.Fprintf(&out, "%s %s\n%s%s\n%s%s %s\n", ConflictStart, aLabel, aLines.String(), ConflictSeparator, bLines.String(), ConflictEnd, bLabel)
+		aLines.Reset()
+		bLines.Reset()
+	}
+
+	for _, op := range Lines(a, b) {
+		switch op.Kind {
+		case Delete:
+			aLines.WriteString(op.Text + "\n")
+		case Insert:
+			bLines.WriteString(op.Text + "\n")
+		default:
+			flush()
+			out.WriteString(op.Text + "\n")
+		}
+	}
+	flush()
+	return out.String()
+}
+
+// HasConflictMarkers reports whether text still contains conflict markers
+func HasConflictMarkers(text string) bool {
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, ConflictStart+" ") || line == ConflictSeparator || strings.HasPrefix(line, ConflictEnd+" ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+/**
+ * textdiff_test.go
+ * Test code for line diffs
+ */
+
+package textdiff
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestLines tests the line diff operations
+func TestLines(t *testing.T) {
+	got := Lines("a\nb\nc\n", "a\nx\nc\nd\n")
+	want := []Op{{Equal, "a"}, {Delete, "b"}, {Insert, "x"}, {Equal, "c"}, {Insert, "d"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %v; want %v", got, want)
+	}
+}
+
+// TestLines_Minimal checks random texts: the diff must rebuild both sides
+// and keep as many lines as their longest common subsequence
+func TestLines_Minimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randomText := func() []string {
+		lines := make([]string, rng.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.Intn(4)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 2000; i++ {
+		x, y := randomText(), randomText()
+		a, b := strings.Join(x, "\n"), strings.Join(y, "\n")
+		ops := Lines(a, b)
+
+		var gotA, gotB []string
+		equal := 0
+		for _, op := range ops {
+			if op.Kind != Insert {
+				gotA = append(gotA, op.Text)
+			}
+			if op.Kind != Delete {
+				gotB = append(gotB, op.Text)
+			}
+			if op.Kind == Equal {
+				equal++
+			}
+		}
+		if strings.Join(gotA, "\n") != a || strings.Join(gotB, "\n") != b {
+			t.Fatalf("Lines(%q, %q) = %v does not rebuild the texts", a, b, ops)
+		}
+		if want := lcsLength(x, y); equal != want {
+			t.Fatalf("Lines(%q, %q) keeps %d lines; want %d", a, b, equal, want)
+		}
+	}
+}
+
+// lcsLength returns the length of the longest common subsequence of x and y
+func lcsLength(x, y []string) int {
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	return lcs[0][0]
+}
+
+// TestLines_Large diffs long texts (a quadratic table would need gigabytes
+// here): scattered edits stay minimal, and a full rewrite is still correct
+func TestLines_Large(t *testing.T) {
+	var x, edited, rewritten []string
+	for i := 0; i < 20000; i++ {
+		x = append(x, fmt.Sprintf("line %d", i))
+		if i%500 == 0 {
+			edited = append(edited, fmt.Sprintf("edited %d", i))
+		} else {
+			edited = append(edited, x[i])
+		}
+		rewritten = append(rewritten, fmt.Sprintf("new %d", i))
+	}
+	a := strings.Join(x, "\n")
+
+	count := func(ops []Op) (equal int, b string) {
+		var lines []string
+		for _, op := range ops {
+			if op.Kind == Equal {
+				equal++
+			}
+			if op.Kind != Delete {
+				lines = append(lines, op.Text)
+			}
+		}
+		return equal, strings.Join(lines, "\n")
+	}
+	if equal, b := count(Lines(a, strings.Join(edited, "\n"))); equal != 20000-40 || b != strings.Join(edited, "\n") {
+		t.Errorf("scattered edits: Lines() keeps %d lines; want %d", equal, 20000-40)
+	}
+	if equal, b := count(Lines(a, strings.Join(rewritten, "\n"))); equal != 0 || b != strings.Join(rewritten, "\n") {
+		t.Errorf("rewrite: Lines() keeps %d lines; want 0", equal)
+	}
+}
+
+// TestUnified tests hunks, context, and line ranges
+func TestUnified(t *testing.T) {
+	var lines []string
+	for _, c := range "abcdefghijklmnop" {
+		lines = append(lines, string(c))
+	}
+	a := strings.Join(lines, "\n") + "\n"
+	lines[1] = "B"
+	lines[14] = "O"
+	b := strings.Join(lines, "\n") + "\n"
+
+	want := "--- old\n+++ new\n" +
+		"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n" +
+		"@@ -12,5 +12,5 @@\n l\n m\n n\n-o\n+O\n p\n"
+	if got := Unified("old", "new", a, b, 3); got != want {
+		t.Errorf("Unified() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestUnified_EdgeCases tests identical texts and empty sides
+func TestUnified_EdgeCases(t *testing.T) {
+	if got := Unified("a", "b", "same\n", "same\n", 3); got != "" {
+		t.Errorf("Unified() of identical texts = %q", got)
+	}
+	want := "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if got := Unified("a", "b", "", "x\ny\n", 3); got != want {
+		t.Errorf("Unified() = %q; want %q", got, want)
+	}
+}
+
+// TestConflicts tests conflict markers around differing regions
+func TestConflicts(t *testing.T) {
+	got := Conflicts("a\nb\nc\n", "a\nB\nc\nd\n", "site", "local")
+	want := "a\n<<<<<<< site\nb\n=======\nB\n>>>>>>> local\nc\n<<<<<<< site\n=======\nd\n>>>>>>> local\n"
+	if got != want {
+		t.Errorf("Conflicts() = %q; want %q", got, want)
+	}
+	if !HasConflictMarkers(got) {
+		t.Error("HasConflictMarkers() = false for merged text")
+	}
+	if HasConflictMarkers("a\n======= not a marker\n") {
+		t.Error("HasConflictMarkers() = true for text without markers")
+	}
+}
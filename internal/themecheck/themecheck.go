@@ -0,0 +1,347 @@
+/**
+ * themecheck.go
+ * Local Ghost theme validation
+ *
+ * Loads a theme from a directory or zip file and checks the basics Ghost
+ * requires before a theme can be uploaded: zip layout, package.json fields,
+ * required templates, and the {{ghost_head}}/{{ghost_foot}} helpers.
+ */
+
+package themecheck
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Severity of a validation issue
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a problem found in a theme
+type Issue struct {
+	File     string   `json:"file,omitempty"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// Theme is a theme loaded for checking
+type Theme struct {
+	// Files maps slash-separated paths (relative to the theme root) to content.
+	// Only templates and package.json are read; other files map to nil.
+	Files map[string][]byte
+
+	// layoutIssues are problems found while locating the theme root in a zip
+	layoutIssues []Issue
+}
+
+// RequiredTemplates are the templates every Ghost theme must have
+var RequiredTemplates = []string{"index.hbs", "post.hbs"}
+
+// IgnoredDirs are directories left out of themes
+var IgnoredDirs = []string{"node_modules", ".git"}
+
+// namePattern matches valid package names (lowercase letters, digits, and hyphens)
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// versionPattern matches semantic versions
+var versionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// ghostHeadPattern and ghostFootPattern match the required helpers
+var (
+	ghostHeadPattern = regexp.MustCompile(`\{\{\{?\s*ghost_head\s*\}?\}\}`)
+	ghostFootPattern = regexp.MustCompile(`\{\{\{?\s*ghost_foot\s*\}?\}\}`)
+)
+
+// Load loads a theme from a directory or a zip file
+func Load(p string) (*Theme, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open theme: %w", err)
+	}
+	if info.IsDir() {
+		return LoadDir(p)
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return LoadZip(data)
+}
+
+// LoadDir loads a theme directory, skipping IgnoredDirs
+func LoadDir(dir string) (*Theme, error) {
+	theme := &Theme{Files: map[string][]byte{}}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != dir && IsIgnored(d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		theme.Files[rel] = nil
+		if needsContent(rel) {
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			theme.Files[rel] = data
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme directory: %w", err)
+	}
+
+	return theme, nil
+}
+
+// LoadZip loads a theme zip
+//
+// Ghost accepts themes at the zip root or inside a single top-level folder.
+func LoadZip(data []byte) (*Theme, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zip: %w", err)
+	}
+
+	// Collect entries, leaving out macOS metadata
+	entries := map[string]*zip.File{}
+	for _, file := range reader.File {
+		name := path.Clean(strings.TrimPrefix(file.Name, "./"))
+		if file.FileInfo().IsDir() || strings.HasPrefix(name, "__MACOSX/") || path.Base(name) == ".DS_Store" {
+			continue
+		}
+		entries[name] = file
+	}
+
+	theme := &Theme{Files: map[string][]byte{}}
+	prefix, layoutIssue := findRoot(entries)
+	if layoutIssue != nil {
+		theme.layoutIssues = append(theme.layoutIssues, *layoutIssue)
+	}
+
+	for name, file := range entries {
+		rel, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+		if first, _, _ := strings.Cut(rel, "/"); IsIgnored(first) {
+			theme.layoutIssues = append(theme.layoutIssues, Issue{File: first, Severity: SeverityWarning, Message: fmt.Sprintf("zip contains %s (leave it out of the upload)", first)})
+			continue
+		}
+
+		theme.Files[rel] = nil
+		if needsContent(rel) {
+			content, err := readZipFile(file)
+			if err != nil {
+				return nil, err
+			}
+			theme.Files[rel] = content
+		}
+	}
+
+	theme.layoutIssues = dedupeIssues(theme.layoutIssues)
+	return theme, nil
+}
+
+// findRoot returns the path prefix of the theme root inside a zip
+func findRoot(entries map[string]*zip.File) (string, *Issue) {
+	if _, ok := entries["package.json"]; ok {
+		return "", nil
+	}
+
+	// A single top-level folder is accepted
+	tops := map[string]bool{}
+	for name := range entries {
+		top, _, nested := strings.Cut(name, "/")
+		if !nested {
+			// Files next to a folder at the root: the theme is the root itself
+			return "", &Issue{File: "package.json", Severity: SeverityError, Message: "package.json not found at the zip root"}
+		}
+		tops[top] = true
+	}
+	if len(tops) == 1 {
+		for top := range tops {
+			if _, ok := entries[top+"/package.json"]; ok {
+				return top + "/", nil
+			}
+		}
+	}
+
+	return "", &Issue{Severity: SeverityError, Message: "package.json not found at the zip root or in a single top-level folder"}
+}
+
+// IsIgnored reports whether a directory name is left out of themes
+func IsIgnored(name string) bool {
+	for _, ignored := range IgnoredDirs {
+		if name == ignored {
+			return true
+		}
+	}
+	return false
+}
+
+// needsContent reports whether a file's content is needed for checks
+func needsContent(rel string) bool {
+	return rel == "package.json" || strings.HasSuffix(rel, ".hbs")
+}
+
+// readZipFile reads a file from a zip
+func readZipFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	return data, nil
+}
+
+// Check validates the theme
+func (t *Theme) Check() []Issue {
+	issues := append([]Issue{}, t.layoutIssues...)
+	issues = append(issues, t.checkPackage()...)
+
+	// Required templates
+	for _, template := range RequiredTemplates {
+		if _, ok := t.Files[template]; !ok {
+			issues = append(issues, Issue{File: template, Severity: SeverityError, Message: fmt.Sprintf("required template %s is missing", template)})
+		}
+	}
+
+	// {{ghost_head}} and {{ghost_foot}} must appear in a template (normally default.hbs)
+	hasHead, hasFoot := false, false
+	for name, content := range t.Files {
+		if !strings.HasSuffix(name, ".hbs") {
+			continue
+		}
+		hasHead = hasHead || ghostHeadPattern.Match(content)
+		hasFoot = hasFoot || ghostFootPattern.Match(content)
+	}
+	if !hasHead {
+		issues = append(issues, Issue{File: "default.hbs", Severity: SeverityError, Message: "{{ghost_head}} helper not found in any template"})
+	}
+	if !hasFoot {
+		issues = append(issues, Issue{File: "default.hbs", Severity: SeverityError, Message: "{{ghost_foot}} helper not found in any template"})
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Severity != issues[j].Severity {
+			return issues[i].Severity == SeverityError
+		}
+		return issues[i].File < issues[j].File
+	})
+	return issues
+}
+
+// packageJSON is the subset of package.json that Ghost checks
+type packageJSON struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Author  *struct {
+		Email string `json:"email"`
+	} `json:"author"`
+	Engines map[string]string      `json:"engines"`
+	Config  map[string]interface{} `json:"config"`
+}
+
+// checkPackage validates package.json
+func (t *Theme) checkPackage() []Issue {
+	const file = "package.json"
+	data, ok := t.Files[file]
+	if !ok {
+		if HasErrors(t.layoutIssues) {
+			// Already reported by the zip layout check
+			return nil
+		}
+		return []Issue{{File: file, Severity: SeverityError, Message: "package.json is missing"}}
+	}
+
+	var pkg packageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return []Issue{{File: file, Severity: SeverityError, Message: fmt.Sprintf("package.json is not valid JSON: %v", err)}}
+	}
+
+	issues := []Issue{}
+	add := func(severity Severity, format string, args ...interface{}) {
+		issues = append(issues, Issue{File: file, Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case pkg.Name == "":
+		add(SeverityError, `"name" is required`)
+	case !namePattern.MatchString(pkg.Name):
+		add(SeverityError, `"name" must be lowercase letters, digits, and hyphens (got %q)`, pkg.Name)
+	}
+	switch {
+	case pkg.Version == "":
+		add(SeverityError, `"version" is required`)
+	case !versionPattern.MatchString(pkg.Version):
+		add(SeverityError, `"version" must be a semantic version like 1.0.0 (got %q)`, pkg.Version)
+	}
+	if pkg.Author == nil || pkg.Author.Email == "" {
+		add(SeverityError, `"author.email" is required`)
+	}
+	if pkg.Engines["ghost"] == "" && pkg.Engines["ghost-api"] == "" {
+		add(SeverityWarning, `"engines.ghost" is not set (declare the supported Ghost versions, e.g. ">=5.0.0")`)
+	}
+	if _, ok := pkg.Config["posts_per_page"]; !ok {
+		add(SeverityWarning, `"config.posts_per_page" is not set`)
+	}
+
+	return issues
+}
+
+// HasErrors reports whether any issue is an error
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupeIssues removes repeated issues, keeping the first occurrence
+func dedupeIssues(issues []Issue) []Issue {
+	seen := map[Issue]bool{}
+	result := []Issue{}
+	for _, issue := range issues {
+		if !seen[issue] {
+			seen[issue] = true
+			result = append(result, issue)
+		}
+	}
+	return result
+}
@@ -0,0 +1,189 @@
+/**
+ * themecheck_test.go
+ * Test code for local theme validation
+ */
+
+package themecheck
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// validPackage is a package.json that passes all checks
+const validPackage = `{
+  "name": "my-theme",
+  "version": "1.0.0",
+  "author": {"email": "dev@example.com"},
+  "engines": {"ghost": ">=5.0.0"},
+  "config": {"posts_per_page": 10}
+}`
+
+// validFiles is a minimal valid theme
+var validFiles = map[string]string{
+	"package.json": validPackage,
+	"default.hbs":  "<html><head>{{ghost_head}}</head><body>{{{body}}}{{ghost_foot}}</body></html>",
+	"index.hbs":    "{{!< default}}{{#foreach posts}}{{title}}{{/foreach}}",
+	"post.hbs":     "{{!< default}}{{#post}}{{content}}{{/post}}",
+}
+
+// writeZip builds a zip with the given files
+func writeZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create zip entry: %v", err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("failed to close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// findIssue reports whether an issue with the severity and message exists
+func findIssue(issues []Issue, severity Severity, message string) bool {
+	for _, issue := range issues {
+		if issue.Severity == severity && strings.Contains(issue.Message, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestLoadDir_ValidTheme tests a valid theme directory, ignoring node_modules
+func TestLoadDir_ValidTheme(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range validFiles {
+		os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	}
+	os.MkdirAll(filepath.Join(dir, "node_modules", "pkg"), 0755)
+	os.WriteFile(filepath.Join(dir, "node_modules", "pkg", "index.hbs"), []byte(""), 0644)
+
+	theme, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if _, ok := theme.Files["node_modules/pkg/index.hbs"]; ok {
+		t.Error("node_modules was not ignored")
+	}
+	if issues := theme.Check(); len(issues) != 0 {
+		t.Errorf("Check() = %+v; want no issues", issues)
+	}
+}
+
+// TestLoadZip_SingleTopLevelFolder tests zips with the theme inside one folder
+func TestLoadZip_SingleTopLevelFolder(t *testing.T) {
+	files := map[string]string{}
+	for name, content := range validFiles {
+		files["my-theme/"+name] = content
+	}
+	files["__MACOSX/my-theme/._index.hbs"] = ""
+
+	theme, err := LoadZip(writeZip(t, files))
+	if err != nil {
+		t.Fatalf("LoadZip() error: %v", err)
+	}
+	if issues := theme.Check(); len(issues) != 0 {
+		t.Errorf("Check() = %+v; want no issues", issues)
+	}
+}
+
+// TestLoadZip_BadLayout tests zips without package.json at an accepted location
+func TestLoadZip_BadLayout(t *testing.T) {
+	files := map[string]string{}
+	for name, content := range validFiles {
+		files["dist/themes/my-theme/"+name] = content
+	}
+
+	theme, err := LoadZip(writeZip(t, files))
+	if err != nil {
+		t.Fatalf("LoadZip() error: %v", err)
+	}
+	issues := theme.Check()
+	if !findIssue(issues, SeverityError, "single top-level folder") {
+		t.Errorf("Check() = %+v; want layout error", issues)
+	}
+	if findIssue(issues, SeverityError, "package.json is missing") {
+		t.Errorf("Check() = %+v; layout error reported twice", issues)
+	}
+}
+
+// TestCheck_Problems tests detection of each kind of problem
+func TestCheck_Problems(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		severity Severity
+		message  string
+	}{
+		{"missing post.hbs", "post.hbs", "", SeverityError, "required template post.hbs is missing"},
+		{"missing ghost_head", "default.hbs", "<body>{{{body}}}{{ghost_foot}}</body>", SeverityError, "{{ghost_head}} helper not found"},
+		{"invalid name", "package.json", `{"name":"My Theme","version":"1.0.0","author":{"email":"a@b.c"}}`, SeverityError, `"name" must be lowercase`},
+		{"invalid version", "package.json", `{"name":"t","version":"v1","author":{"email":"a@b.c"}}`, SeverityError, `"version" must be a semantic version`},
+		{"missing author email", "package.json", `{"name":"t","version":"1.0.0"}`, SeverityError, `"author.email" is required`},
+		{"missing engines", "package.json", `{"name":"t","version":"1.0.0","author":{"email":"a@b.c"}}`, SeverityWarning, `"engines.ghost" is not set`},
+		{"invalid JSON", "package.json", `{`, SeverityError, "not valid JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			theme := &Theme{Files: map[string][]byte{}}
+			for name, content := range validFiles {
+				theme.Files[name] = []byte(content)
+			}
+			if tt.content == "" {
+				delete(theme.Files, tt.file)
+			} else {
+				theme.Files[tt.file] = []byte(tt.content)
+			}
+
+			if issues := theme.Check(); !findIssue(issues, tt.severity, tt.message) {
+				t.Errorf("Check() = %+v; want %s containing %q", issues, tt.severity, tt.message)
+			}
+		})
+	}
+}
+
+// TestBuildZip_RoundTrip tests packaging a directory and loading the result
+func TestBuildZip_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range validFiles {
+		os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	}
+	os.MkdirAll(filepath.Join(dir, ".git"), 0755)
+	os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref"), 0644)
+	os.MkdirAll(filepath.Join(dir, "partials"), 0755)
+	os.WriteFile(filepath.Join(dir, "partials", "card.hbs"), []byte("card"), 0644)
+
+	data, err := BuildZip(dir)
+	if err != nil {
+		t.Fatalf("BuildZip() error: %v", err)
+	}
+	theme, err := LoadZip(data)
+	if err != nil {
+		t.Fatalf("LoadZip() error: %v", err)
+	}
+
+	if _, ok := theme.Files["partials/card.hbs"]; !ok {
+		t.Error("partials/card.hbs missing from zip")
+	}
+	if _, ok := theme.Files[".git/HEAD"]; ok {
+		t.Error(".git was not ignored")
+	}
+	if issues := theme.Check(); len(issues) != 0 {
+		t.Errorf("Check() = %+v; want no issues", issues)
+	}
+	if theme.Name() != "my-theme" {
+		t.Errorf("Name() = %q; want %q", theme.Name(), "my-theme")
+	}
+}
@@ -0,0 +1,85 @@
+/**
+ * zip.go
+ * In-memory theme packaging
+ *
+ * Builds an upload-ready zip from a theme directory, leaving out
+ * IgnoredDirs and macOS metadata files.
+ */
+
+package themecheck
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// BuildZip packages a theme directory as a zip with the theme at its root
+func BuildZip(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != dir && IsIgnored(d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() == ".DS_Store" || !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+
+		w, err := writer.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(w, file)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build theme zip: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build theme zip: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Name returns the package name from package.json, or "" if unavailable
+func (t *Theme) Name() string {
+	var pkg packageJSON
+	if err := json.Unmarshal(t.Files["package.json"], &pkg); err != nil {
+		return ""
+	}
+	return pkg.Name
+}
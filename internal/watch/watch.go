@@ -0,0 +1,140 @@
+/**
+ * watch.go
+ * Polling file watcher
+ *
+ * Detects changes in a directory tree by polling file sizes and modification
+ * times, and reports them after a debounce period so that a burst of saves
+ * produces a single event.
+ */
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// fileState is what is compared between scans
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// State is the state of a directory tree, keyed by relative path
+type State map[string]fileState
+
+// Options configures a watcher
+type Options struct {
+	Interval time.Duration          // Polling interval
+	Debounce time.Duration          // Quiet period before changes are reported
+	Skip     func(name string) bool // Directories to leave out (by name)
+}
+
+// Validate checks that the interval is positive and the debounce is not negative
+func (o Options) Validate() error {
+	if o.Interval <= 0 {
+		return fmt.Errorf("interval must be positive (got %s)", o.Interval)
+	}
+	if o.Debounce < 0 {
+		return fmt.Errorf("debounce must not be negative (got %s)", o.Debounce)
+	}
+	return nil
+}
+
+// Scan records the state of every file under dir
+func Scan(dir string, skip func(name string) bool) (State, error) {
+	state := State{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != dir && skip != nil && skip(d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		state[filepath.ToSlash(rel)] = fileState{size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+	return state, err
+}
+
+// Changed returns the paths that differ between two states, sorted
+func (s State) Changed(other State) []string {
+	changed := []string{}
+	for p, before := range s {
+		if after, ok := other[p]; !ok || after != before {
+			changed = append(changed, p)
+		}
+	}
+	for p := range other {
+		if _, ok := s[p]; !ok {
+			changed = append(changed, p)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// Poll watches dir until ctx is done, calling onChange with the changed paths
+//
+// onChange runs on the polling goroutine, so changes made while it runs are
+// reported on the next call. Scan errors (e.g. a file removed mid-scan) are
+// retried on the next tick.
+func Poll(ctx context.Context, dir string, opts Options, onChange func(changed []string)) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	last, err := Scan(dir, opts.Skip)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	pending := map[string]bool{}
+	var lastChange time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			current, err := Scan(dir, opts.Skip)
+			if err != nil {
+				continue
+			}
+			if changed := last.Changed(current); len(changed) > 0 {
+				for _, p := range changed {
+					pending[p] = true
+				}
+				lastChange = now
+				last = current
+			}
+
+			// Report once the tree has been quiet for the debounce period
+			if len(pending) > 0 && now.Sub(lastChange) >= opts.Debounce {
+				paths := make([]string, 0, len(pending))
+				for p := range pending {
+					paths = append(paths, p)
+				}
+				sort.Strings(paths)
+				pending = map[string]bool{}
+				onChange(paths)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+/**
+ * watch_test.go
+ * Test code for the polling file watcher
+ */
+
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestScan_ChangedAndSkip tests detecting changes and skipping directories
+func TestScan_ChangedAndSkip(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "a.hbs"), []byte("a"), 0644)
+	os.MkdirAll(filepath.Join(dir, "node_modules"), 0755)
+	os.WriteFile(filepath.Join(dir, "node_modules", "x.js"), []byte("x"), 0644)
+
+	skip := func(name string) bool { return name == "node_modules" }
+	before, err := Scan(dir, skip)
+	if err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	if _, ok := before["node_modules/x.js"]; ok {
+		t.Error("node_modules was not skipped")
+	}
+
+	os.WriteFile(filepath.Join(dir, "a.hbs"), []byte("changed"), 0644)
+	os.WriteFile(filepath.Join(dir, "b.hbs"), []byte("b"), 0644)
+	os.WriteFile(filepath.Join(dir, "node_modules", "x.js"), []byte("changed"), 0644)
+
+	after, _ := Scan(dir, skip)
+	if got := before.Changed(after); !reflect.DeepEqual(got, []string{"a.hbs", "b.hbs"}) {
+		t.Errorf("Changed() = %v; want [a.hbs b.hbs]", got)
+	}
+}
+
+// TestPoll_DebouncesBurst tests that a burst of changes is reported once
+func TestPoll_DebouncesBurst(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := make(chan []string, 10)
+	go Poll(ctx, dir, Options{Interval: 10 * time.Millisecond, Debounce: 100 * time.Millisecond}, func(changed []string) {
+		events <- changed
+	})
+	time.Sleep(30 * time.Millisecond)
+
+	// Three saves in quick succession
+	for _, name := range []string{"a.hbs", "b.hbs", "c.hbs"} {
+		os.WriteFile(filepath.Join(dir, name), []byte(name), 0644)
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	select {
+	case changed := <-events:
+		if !reflect.DeepEqual(changed, []string{"a.hbs", "b.hbs", "c.hbs"}) {
+			t.Errorf("changed = %v; want all three files", changed)
+		}
+	case <-ctx.Done():
+		t.Fatal("no change reported")
+	}
+
+	select {
+	case changed := <-events:
+		t.Errorf("unexpected second event: %v", changed)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+// TestPoll_RejectsInvalidOptions tests that a non-positive interval or negative debounce is an error
+func TestPoll_RejectsInvalidOptions(t *testing.T) {
+	for _, opts := range []Options{
+		{Interval: 0},
+		{Interval: -time.Second},
+		{Interval: time.Second, Debounce: -time.Millisecond},
+	} {
+		if err := Poll(context.Background(), t.TempDir(), opts, func([]string) {}); err == nil {
+			t.Errorf("Poll(%+v) error = nil; want error", opts)
+		}
+	}
+}